@@ -239,11 +239,14 @@ func Newahchain(logger log.Logger, db dbm.DB, loadLatest bool,
 		app.appAccountKeeper,
 		app.truBankKeeper,
 		app.claimKeeper,
+		app.communityKeeper,
 		app.supplyKeeper,
 		truStakingSubspace,
 		trustaking.DefaultCodespace,
 	)
 
+	app.truBankKeeper = app.truBankKeeper.SetTipKeepers(app.appAccountKeeper, app.truStakingKeeper)
+
 	app.truSlashingKeeper = truslashing.NewKeeper(
 		keys[truslashing.StoreKey],
 		truSlashingSubspace,
@@ -289,7 +292,7 @@ func Newahchain(logger log.Logger, db dbm.DB, loadLatest bool,
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, trudist.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, trustaking.ModuleName, truslashing.ModuleName, account.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, claim.ModuleName, trustaking.ModuleName, truslashing.ModuleName, account.ModuleName, trubank.ModuleName)
 
 	// genutils must occur after staking so that pools are properly
 	// initialized with tokens from genesis accounts.
@@ -309,10 +312,8 @@ func Newahchain(logger log.Logger, db dbm.DB, loadLatest bool,
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	// The AnteHandler handles signature verification and transaction pre-processing
-	// TODO [shanev]: see https://github.com/ahmedaly113/ahchain/issues/364
-	// Add this back after fixing issues with signature verification
-	//app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	// The AnteHandler handles signature verification, fees and rate limits
+	app.SetAnteHandler(account.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.appAccountKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -2,7 +2,11 @@ package app
 
 import (
 	"encoding/json"
+	"io"
 	"log"
+	"time"
+
+	trubank "github.com/ahmedaly113/ahchain/x/bank"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -162,3 +166,22 @@ func (app *ahchain) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []st
 	)
 
 }
+
+// ExportTransactions writes the bank transactions created before a time as JSON lines,
+// so they can be archived before pruning drops them. A zero time writes all of them.
+func (app *ahchain) ExportTransactions(w io.Writer, before time.Time) (err error) {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	app.truBankKeeper.IterateTransactions(ctx, func(tx trubank.Transaction) bool {
+		if !before.IsZero() && !tx.CreatedTime.Before(before) {
+			return true
+		}
+		var bz []byte
+		bz, err = app.codec.MarshalJSON(tx)
+		if err != nil {
+			return true
+		}
+		_, err = w.Write(append(bz, '\n'))
+		return err != nil
+	})
+	return err
+}
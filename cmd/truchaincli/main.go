@@ -52,6 +52,7 @@ func main() {
 	// Construct Root Command
 	rootCmd.AddCommand(
 		SendGiftCmd(cdc),
+		SendGiftBatchCmd(cdc),
 		client.LineBreak,
 		NewCommunityCmd(cdc),
 		client.LineBreak,
@@ -362,6 +362,11 @@ func SlashingParamsCmd(cdc *codec.Codec) *cobra.Command {
 						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(
 							makeCosmosObject(field.Type.String(), cmd.Flag(param).Value.String()),
 						)
+					} else if field.Type.Kind() == reflect.Slice {
+						// lists such as reason_punishments are passed as JSON
+						list := reflect.New(field.Type)
+						cdc.MustUnmarshalJSON([]byte(input), list.Interface())
+						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(list.Elem())
 					} else {
 						mapInput[param] = input
 					}
@@ -438,6 +443,11 @@ func StakingParamsCmd(cdc *codec.Codec) *cobra.Command {
 						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(
 							makeCosmosObject(field.Type.String(), cmd.Flag(param).Value.String()),
 						)
+					} else if field.Type.Kind() == reflect.Slice {
+						// lists such as stake_limit_tiers are passed as JSON
+						list := reflect.New(field.Type)
+						cdc.MustUnmarshalJSON([]byte(input), list.Interface())
+						reflect.ValueOf(&updates).Elem().FieldByName(field.Name).Set(list.Elem())
 					} else {
 						mapInput[param] = input
 					}
@@ -514,6 +524,14 @@ func makeCosmosObject(cosmosType string, value string) reflect.Value {
 		return reflect.ValueOf(dec)
 	}
 
+	if cosmosType == "types.Int" {
+		i, ok := sdk.NewIntFromString(value)
+		if !ok {
+			panic(fmt.Sprintf("invalid integer %s", value))
+		}
+		return reflect.ValueOf(i)
+	}
+
 	if cosmosType == "types.Coin" {
 		coin, err := sdk.ParseCoin(value)
 		if err != nil {
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/bank"
+)
+
+const flagCampaignID = "campaign-id"
+
+// giftRecord is a row of a gift batch file
+type giftRecord struct {
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount"`
+}
+
+// SendGiftBatchCmd will create a gift batch tx from a recipients file and sign it with the given key.
+func SendGiftBatchCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send_gift_batch [from_key_or_address] [recipients_file]",
+		Short: "Create and sign a gift batch tx",
+		Long: `Gift many recipients in one tx. The recipients file is either JSON:
+
+[{"recipient": "tru1...", "amount": "10000000utru"}]
+
+or CSV, with an optional recipient,amount header:
+
+tru1...,10000000utru
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			gifts, err := readGifts(args[1])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := bank.NewMsgSendGiftBatch(cliCtx.GetFromAddress(), gifts, viper.GetString(flagCampaignID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			fromName := cliCtx.GetFromName()
+			passphrase, err := keys.GetPassphrase(fromName)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txBldr.BuildAndSign(fromName, passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			// broadcast to a Tendermint node
+			res, err := cliCtx.WithBroadcastMode(client.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(res)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagCampaignID, "", "Campaign the gifts are sent for")
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// readGifts reads the gifts of a batch from a JSON or CSV file
+func readGifts(path string) ([]bank.Gift, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []giftRecord
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		records, err = readJSONGifts(f)
+	} else {
+		records, err = readCSVGifts(f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gifts := make([]bank.Gift, 0, len(records))
+	for i, record := range records {
+		recipient, err := sdk.AccAddressFromBech32(strings.TrimSpace(record.Recipient))
+		if err != nil {
+			return nil, fmt.Errorf("gift %d: %s", i+1, err)
+		}
+		amount, err := sdk.ParseCoin(strings.TrimSpace(record.Amount))
+		if err != nil {
+			return nil, fmt.Errorf("gift %d: %s", i+1, err)
+		}
+		if amount.Denom != app.StakeDenom {
+			return nil, fmt.Errorf("gift %d: invalid denomination coin got %s wanted %s", i+1, amount.Denom, app.StakeDenom)
+		}
+		gifts = append(gifts, bank.Gift{Recipient: recipient, Amount: amount})
+	}
+	return gifts, nil
+}
+
+func readJSONGifts(r io.Reader) ([]giftRecord, error) {
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var records []giftRecord
+	if err := json.Unmarshal(bz, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func readCSVGifts(r io.Reader) ([]giftRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > 0 && strings.EqualFold(rows[0][0], "recipient") {
+		rows = rows[1:]
+	}
+	records := make([]giftRecord, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, giftRecord{Recipient: row[0], Amount: row[1]})
+	}
+	return records, nil
+}
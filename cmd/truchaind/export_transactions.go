@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ahmedaly113/ahchain/app"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagHeight = "height"
+	flagBefore = "before"
+)
+
+// ExportTransactionsCmd dumps the bank transactions of a snapshot as JSON lines,
+// to archive the transactions the bank module prunes from state
+func ExportTransactionsCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-transactions",
+		Short: "Export bank transactions to JSON lines",
+		Long: `Export the bank transactions of a snapshot to STDOUT, one JSON object per line.
+Run it against a stopped node, before the transactions are past the TransactionRetention param.
+Example:
+$ ahchaind export-transactions --height 100000 --before 2020-01-01T00:00:00Z > transactions.jsonl
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var before time.Time
+			if b := viper.GetString(flagBefore); b != "" {
+				var err error
+				before, err = time.Parse(time.RFC3339, b)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %s", flagBefore, err)
+				}
+			}
+
+			home := viper.GetString(cli.HomeFlag)
+			db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := viper.GetInt64(flagHeight)
+			tApp := app.Newahchain(ctx.Logger, db, height == -1, uint(1))
+			if height != -1 {
+				if err := tApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+			return tApp.ExportTransactions(cmd.OutOrStdout(), before)
+		},
+	}
+	cmd.Flags().Int64(flagHeight, -1, "Export transactions from a particular height (-1 for the latest height)")
+	cmd.Flags().String(flagBefore, "", "Only export transactions created before this RFC3339 time")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPageLimit is the largest page a list query returns
+const MaxPageLimit = 100
+
+// SortOrder is the direction a list query walks its index
+type SortOrder int8
+
+// Sort orders, SortDefault keeps the order each query used before pagination
+const (
+	SortDefault SortOrder = iota
+	SortAsc
+	SortDesc
+)
+
+// Pagination is added to list query params to fetch results page by page.
+// Cursor is the NextCursor returned with the previous page.
+type Pagination struct {
+	Limit     int       `json:"limit"`
+	Cursor    string    `json:"cursor,omitempty"`
+	SortOrder SortOrder `json:"sort_order"`
+}
+
+// Paginated is false for legacy requests that expect the full result set
+func (p Pagination) Paginated() bool {
+	return p.Limit > 0 || p.Cursor != ""
+}
+
+// PaginateStore walks the values stored under prefix honoring the pagination.
+// cb returns false for values that shouldn't count towards the page.
+// It returns the cursor for the next page, which is empty on the last page.
+func PaginateStore(store sdk.KVStore, prefix []byte, p Pagination, defaultOrder SortOrder,
+	cb func(value []byte) (accepted bool)) (string, error) {
+	limit := p.Limit
+	if limit <= 0 || limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+	order := p.SortOrder
+	if order == SortDefault {
+		order = defaultOrder
+	}
+	if order != SortAsc && order != SortDesc {
+		return "", fmt.Errorf("invalid sort order %d", p.SortOrder)
+	}
+
+	start, end := prefix, sdk.PrefixEndBytes(prefix)
+	if p.Cursor != "" {
+		suffix, err := base64.URLEncoding.DecodeString(p.Cursor)
+		if err != nil {
+			return "", fmt.Errorf("invalid cursor %s", p.Cursor)
+		}
+		cursorKey := append(append([]byte{}, prefix...), suffix...)
+		if order == SortAsc {
+			// smallest key strictly after the cursor
+			start = append(cursorKey, 0x00)
+		} else {
+			end = cursorKey
+		}
+	}
+
+	var iterator sdk.Iterator
+	if order == SortAsc {
+		iterator = store.Iterator(start, end)
+	} else {
+		iterator = store.ReverseIterator(start, end)
+	}
+	defer iterator.Close()
+
+	count := 0
+	var last []byte
+	for ; iterator.Valid() && count < limit; iterator.Next() {
+		if cb(iterator.Value()) {
+			count++
+			// the iterator may reuse the key's backing array once it advances
+			last = append([]byte{}, iterator.Key()...)
+		}
+	}
+	if !iterator.Valid() || last == nil {
+		return "", nil
+	}
+	return base64.URLEncoding.EncodeToString(last[len(prefix):]), nil
+}
@@ -125,14 +125,7 @@ type InterestDistributionResults struct {
 	Interests   []Interest `json:"interests"`
 }
 
+// KVGasConfig returns the gas costs of the module stores
 func KVGasConfig() stypes.GasConfig {
-	return stypes.GasConfig{
-		HasCost:          0,
-		DeleteCost:       0,
-		ReadCostFlat:     0,
-		ReadCostPerByte:  0,
-		WriteCostFlat:    0,
-		WriteCostPerByte: 0,
-		IterNextCostFlat: 0,
-	}
+	return stypes.KVGasConfig()
 }
@@ -9,6 +9,7 @@ import (
 // EndBlocker called every block, process expiring stakes
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	keeper.unjailAccounts(ctx)
+	keeper.payReferralRewards(ctx)
 }
 
 func (k Keeper) unjailAccounts(ctx sdk.Context) {
@@ -18,7 +19,7 @@ func (k Keeper) unjailAccounts(ctx sdk.Context) {
 	}
 
 	for _, acct := range toUnjail {
-		err = k.UnJail(ctx, acct.PrimaryAddress())
+		err = k.UnJail(ctx, acct.Identity)
 		if err != nil {
 			panic(err)
 		}
@@ -26,7 +27,7 @@ func (k Keeper) unjailAccounts(ctx sdk.Context) {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				EventTypeUnjailedAccount,
-				sdk.NewAttribute(AttributeKeyUser, acct.PrimaryAddress().String()),
+				sdk.NewAttribute(AttributeKeyUser, acct.Identity.String()),
 			),
 		)
 
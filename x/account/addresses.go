@@ -0,0 +1,119 @@
+package account
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Identity returns the identity of the AppAccount an address is linked to.
+// Stakes, slashes and earnings of every linked address are attributed to it.
+// Addresses without an AppAccount are their own identity.
+func (k Keeper) Identity(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, sdk.Error) {
+	store := k.store(ctx)
+	if bz := store.Get(linkedAddressKey(address)); bz != nil {
+		return sdk.AccAddress(bz), nil
+	}
+	// the address an account was registered with can be unlinked from it
+	if store.Has(key(address)) {
+		return nil, ErrAddressNotLinked(address)
+	}
+	return address, nil
+}
+
+// AddKey links a new address to the AppAccount of its primary address
+func (k Keeper) AddKey(ctx sdk.Context, primary, address sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, err := k.managedAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	if _, ok := k.getAppAccount(ctx, address); ok {
+		return user, ErrAddressAlreadyLinked(address)
+	}
+	// the new key needs an auth account to sign transactions
+	if k.accountKeeper.GetAccount(ctx, address) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, address))
+	}
+
+	user.Addresses = append(user.Addresses, address)
+	k.setAppAccount(ctx, user)
+	k.setLinkedAddress(ctx, address, user.Identity)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Linked %s to %s", address, user.Identity))
+
+	return user, nil
+}
+
+// RemoveKey unlinks an address from the AppAccount of its primary address
+func (k Keeper) RemoveKey(ctx sdk.Context, primary, address sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, err := k.managedAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	if user.PrimaryAddress().Equals(address) {
+		return user, ErrCannotRemovePrimary(address)
+	}
+	if !user.HasAddress(address) {
+		return user, ErrAddressNotLinked(address)
+	}
+
+	addresses := make([]sdk.AccAddress, 0, len(user.Addresses)-1)
+	for _, linked := range user.Addresses {
+		if !linked.Equals(address) {
+			addresses = append(addresses, linked)
+		}
+	}
+	user.Addresses = addresses
+	k.setAppAccount(ctx, user)
+	k.store(ctx).Delete(linkedAddressKey(address))
+
+	k.Logger(ctx).Info(fmt.Sprintf("Unlinked %s from %s", address, user.Identity))
+
+	return user, nil
+}
+
+// PromoteKey makes a linked address the primary address of the AppAccount
+func (k Keeper) PromoteKey(ctx sdk.Context, primary, address sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, err := k.managedAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	if !user.HasAddress(address) {
+		return user, ErrAddressNotLinked(address)
+	}
+
+	addresses := []sdk.AccAddress{address}
+	for _, linked := range user.Addresses {
+		if !linked.Equals(address) {
+			addresses = append(addresses, linked)
+		}
+	}
+	user.Addresses = addresses
+	k.setAppAccount(ctx, user)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Promoted %s to primary address of %s", address, user.Identity))
+
+	return user, nil
+}
+
+// managedAccount returns the AppAccount an address is the primary address of
+func (k Keeper) managedAccount(ctx sdk.Context, primary sdk.AccAddress) (AppAccount, sdk.Error) {
+	if _, err := k.Identity(ctx, primary); err != nil {
+		return AppAccount{}, err
+	}
+	user, ok := k.getAppAccount(ctx, primary)
+	if !ok {
+		return user, ErrAppAccountNotFound(primary)
+	}
+	if !user.PrimaryAddress().Equals(primary) {
+		return user, ErrNotPrimaryAddress(primary)
+	}
+	if user.IsDeactivated() {
+		return user, ErrAccountDeactivated(primary)
+	}
+	return user, nil
+}
+
+func (k Keeper) setLinkedAddress(ctx sdk.Context, address, identity sdk.AccAddress) {
+	k.store(ctx).Set(linkedAddressKey(address), identity)
+}
@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	TransactionGift    = exported.TransactionGift
-	TransactionBacking = exported.TransactionBacking
+	TransactionGift           = exported.TransactionGift
+	TransactionBacking        = exported.TransactionBacking
+	TransactionReferralReward = exported.TransactionReferralReward
 
 	UserGrowthPoolName = distribution.UserGrowthPoolName
 )
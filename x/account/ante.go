@@ -0,0 +1,281 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// MsgRateLimit caps how many messages of a type an account can send in RateLimitPeriod
+type MsgRateLimit struct {
+	MsgType string `json:"msg_type"`
+	Limit   int    `json:"limit"`
+}
+
+// rateLimitCounter counts the messages of a type an account sent in the current window
+type rateLimitCounter struct {
+	WindowStart time.Time `json:"window_start"`
+	Count       int       `json:"count"`
+}
+
+// NewAnteHandler returns an AnteHandler that checks signatures and sequences,
+// deducts fees and rate limits messages. Registered users pay no fees for
+// transactions made only of FeeExemptMsgTypes, and self registrations pay none
+// while they would be accepted by SelfRegister. Keys self registering or being
+// linked have no auth account yet and are checked against the public key they sign with.
+func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper supply.Keeper, k Keeper,
+	sigGasConsumer auth.SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		if addr := supplyKeeper.GetModuleAddress(auth.FeeCollectorName); addr == nil {
+			panic(fmt.Sprintf("%s module account has not been set", auth.FeeCollectorName))
+		}
+
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			newCtx = auth.SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+		}
+
+		params := ak.GetParams(ctx)
+		newCtx = auth.SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+
+		// out of gas panics are turned into an error result with the gas used so far
+		defer func() {
+			if r := recover(); r != nil {
+				switch rType := r.(type) {
+				case sdk.ErrorOutOfGas:
+					log := fmt.Sprintf(
+						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+						rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
+					)
+					res = sdk.ErrOutOfGas(log).Result()
+					res.GasWanted = stdTx.Fee.Gas
+					res.GasUsed = newCtx.GasMeter().GasConsumed()
+					abort = true
+				default:
+					panic(r)
+				}
+			}
+		}()
+
+		if res := auth.ValidateSigCount(stdTx, params); !res.IsOK() {
+			return newCtx, res, true
+		}
+		if err := tx.ValidateBasic(); err != nil {
+			return newCtx, err.Result(), true
+		}
+		newCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(newCtx.TxBytes())), "txSize")
+		if res := auth.ValidateMemo(stdTx, params); !res.IsOK() {
+			return newCtx, res, true
+		}
+
+		signerAddrs := stdTx.GetSigners()
+		signerAccs := make([]authexported.Account, len(signerAddrs))
+		unregistered := make([]bool, len(signerAddrs))
+		selfRegistration := isSelfRegistration(stdTx.GetMsgs())
+		linkedKeys := addedKeys(stdTx.GetMsgs())
+		for i := 0; i < len(signerAddrs); i++ {
+			acc := ak.GetAccount(newCtx, signerAddrs[i])
+			if acc == nil && (selfRegistration || linkedKeys[signerAddrs[i].String()]) {
+				// the account is created by the registration or the link itself
+				baseAccount := auth.NewBaseAccountWithAddress(signerAddrs[i])
+				acc, unregistered[i] = &baseAccount, true
+			}
+			if acc == nil {
+				return newCtx, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", signerAddrs[i])).Result(), true
+			}
+			signerAccs[i] = acc
+		}
+
+		feeExempt := k.feeExempt(newCtx, stdTx.GetMsgs(), signerAddrs)
+		if selfRegistration {
+			feeExempt = k.selfRegistrationFeeExempt(newCtx, stdTx.GetMsgs())
+		}
+		if !feeExempt {
+			if ctx.IsCheckTx() && !simulate {
+				res := auth.EnsureSufficientMempoolFees(newCtx, stdTx.Fee)
+				if !res.IsOK() {
+					return newCtx, res, true
+				}
+			}
+			if !stdTx.Fee.Amount.IsZero() {
+				res := auth.DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
+				if !res.IsOK() {
+					return newCtx, res, true
+				}
+				signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
+			}
+		}
+
+		isGenesis := ctx.BlockHeight() == 0
+		stdSigs := stdTx.GetSignatures()
+		for i := 0; i < len(stdSigs); i++ {
+			signBytes := auth.GetSignBytes(newCtx.ChainID(), stdTx, signerAccs[i], isGenesis)
+			signerAccs[i], res = processSig(newCtx, signerAccs[i], stdSigs[i], signBytes, simulate, params, sigGasConsumer)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+			if !unregistered[i] {
+				ak.SetAccount(newCtx, signerAccs[i])
+			}
+		}
+
+		if err := k.rateLimit(newCtx, stdTx.GetMsgs()); err != nil {
+			return newCtx, err.Result(), true
+		}
+
+		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false
+	}
+}
+
+// processSig verifies a signature with the public key of the signer account,
+// or the key of the signature when the account has none yet, and increments the sequence
+func processSig(ctx sdk.Context, acc authexported.Account, sig auth.StdSignature, signBytes []byte,
+	simulate bool, params auth.Params, sigGasConsumer auth.SignatureVerificationGasConsumer) (authexported.Account, sdk.Result) {
+	pubKey, res := auth.ProcessPubKey(acc, sig, simulate)
+	if !res.IsOK() {
+		return nil, res
+	}
+	err := acc.SetPubKey(pubKey)
+	if err != nil {
+		return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
+	}
+
+	if res := sigGasConsumer(ctx.GasMeter(), sig.Signature, pubKey, params); !res.IsOK() {
+		return nil, res
+	}
+	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
+		return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
+	}
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		panic(err)
+	}
+
+	return acc, res
+}
+
+func isSelfRegistration(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(MsgSelfRegister); !ok {
+			return false
+		}
+	}
+	return len(msgs) > 0
+}
+
+// addedKeys returns the addresses linked by the MsgAddKey messages, they co-sign the link
+func addedKeys(msgs []sdk.Msg) map[string]bool {
+	keys := make(map[string]bool)
+	for _, msg := range msgs {
+		if addKey, ok := msg.(MsgAddKey); ok {
+			keys[addKey.Address.String()] = true
+		}
+	}
+	return keys
+}
+
+// feeExempt tells whether all messages are FeeExemptMsgTypes
+// and all signers are addresses of active AppAccounts or keys being linked to one
+func (k Keeper) feeExempt(ctx sdk.Context, msgs []sdk.Msg, signers []sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+	for _, msg := range msgs {
+		if !isIn(msg.Type(), params.FeeExemptMsgTypes) {
+			return false
+		}
+	}
+	linkedKeys := addedKeys(msgs)
+	for _, signer := range signers {
+		if linkedKeys[signer.String()] {
+			continue
+		}
+		if _, err := k.Identity(ctx, signer); err != nil {
+			return false
+		}
+		user, ok := k.getAppAccount(ctx, signer)
+		if !ok || user.IsDeactivated() {
+			return false
+		}
+	}
+	return true
+}
+
+// selfRegistrationFeeExempt tells whether self registration is enabled and every
+// registration carries a valid proof and fits under the per block and per day caps.
+// Handlers don't run in CheckTx, so there the counter is kept by the ante handler
+// itself, and registrations past the caps pay fees to enter the mempool.
+func (k Keeper) selfRegistrationFeeExempt(ctx sdk.Context, msgs []sdk.Msg) bool {
+	if !k.GetParams(ctx).SelfRegistration {
+		return false
+	}
+	counter := k.registrationCounter(ctx)
+	for _, msg := range msgs {
+		register := msg.(MsgSelfRegister)
+		if err := k.verifyRegistrationProof(ctx, register.Address, register.Nonce, register.Voucher); err != nil {
+			return false
+		}
+		var err sdk.Error
+		counter, err = k.countRegistration(ctx, counter)
+		if err != nil {
+			return false
+		}
+	}
+	if ctx.IsCheckTx() {
+		k.setRegistrationCounter(ctx, counter)
+	}
+	return true
+}
+
+// rateLimit counts the rate limited messages against the AppAccount of their signers,
+// the count starts over RateLimitPeriod after the first message of a window
+func (k Keeper) rateLimit(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	params := k.GetParams(ctx)
+	now := ctx.BlockHeader().Time
+	for _, msg := range msgs {
+		limit, ok := params.msgRateLimit(msg.Type())
+		if !ok {
+			continue
+		}
+		for _, signer := range msg.GetSigners() {
+			identity, err := k.Identity(ctx, signer)
+			if err != nil {
+				return err
+			}
+			counter := k.rateLimitCounter(ctx, identity, msg.Type())
+			if !now.Before(counter.WindowStart.Add(params.RateLimitPeriod)) {
+				counter = rateLimitCounter{WindowStart: now}
+			}
+			if counter.Count >= limit.Limit {
+				return ErrRateLimitExceeded(msg.Type(), limit.Limit, params.RateLimitPeriod)
+			}
+			counter.Count++
+			k.setRateLimitCounter(ctx, identity, msg.Type(), counter)
+		}
+	}
+	return nil
+}
+
+func (p Params) msgRateLimit(msgType string) (MsgRateLimit, bool) {
+	for _, limit := range p.MsgRateLimits {
+		if limit.MsgType == msgType {
+			return limit, true
+		}
+	}
+	return MsgRateLimit{}, false
+}
+
+func (k Keeper) rateLimitCounter(ctx sdk.Context, address sdk.AccAddress, msgType string) (counter rateLimitCounter) {
+	bz := k.store(ctx).Get(rateLimitKey(address, msgType))
+	if bz == nil {
+		return
+	}
+	k.codec.MustUnmarshalBinaryBare(bz, &counter)
+	return
+}
+
+func (k Keeper) setRateLimitCounter(ctx sdk.Context, address sdk.AccAddress, msgType string, counter rateLimitCounter) {
+	k.store(ctx).Set(rateLimitKey(address, msgType), k.codec.MustMarshalBinaryBare(counter))
+}
@@ -10,6 +10,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(AppAccount{}, "ahchain/AppAccount", nil)
 	cdc.RegisterConcrete(PrimaryAccount{}, "ahchain/PrimaryAccount", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "account/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(MsgAddKey{}, "account/MsgAddKey", nil)
+	cdc.RegisterConcrete(MsgRemoveKey{}, "account/MsgRemoveKey", nil)
+	cdc.RegisterConcrete(MsgPromoteKey{}, "account/MsgPromoteKey", nil)
+	cdc.RegisterConcrete(MsgSelfRegister{}, "account/MsgSelfRegister", nil)
+	cdc.RegisterConcrete(MsgCreateInviteCode{}, "account/MsgCreateInviteCode", nil)
+	cdc.RegisterConcrete(MsgDeactivateAccount{}, "account/MsgDeactivateAccount", nil)
 }
 
 // ModuleCodec encodes module codec
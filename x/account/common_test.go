@@ -26,27 +26,60 @@ import (
 )
 
 // interface conformance check
-var _ BankKeeper = bankKeeper{}
+var _ BankKeeper = &bankKeeper{}
 
-type transaction struct {
-	Address sdk.Address
-	Coin    sdk.Coin
-}
 type bankKeeper struct {
-	Transactions []transaction
+	Transactions []bankexported.Transaction
 }
 
 // AddCoin mock for bank keeper
-func (bk bankKeeper) AddCoin(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin,
+func (bk *bankKeeper) AddCoin(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin,
 	referenceID uint64, txType bankexported.TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error) {
 
-	txn := transaction{to, coin}
+	txn := bankexported.Transaction{
+		Type:              txType,
+		AppAccountAddress: to,
+		ReferenceID:       referenceID,
+		Amount:            coin,
+	}
+	for _, setter := range setters {
+		setter(&txn)
+	}
 	bk.Transactions = append(bk.Transactions, txn)
 	return sdk.Coins{coin}, nil
 }
 
-func (bk bankKeeper) IterateUserTransactions(ctx sdk.Context, creator sdk.AccAddress, reverse bool, cb func(transaction bankexported.Transaction) (stop bool)) {
+func (bk *bankKeeper) TransactionSummary(ctx sdk.Context, address sdk.AccAddress, filterSetters ...bankexported.Filter) []bankexported.TransactionSummary {
+	filters := bankexported.GetFilters(filterSetters...)
+	summaries := make([]bankexported.TransactionSummary, 0)
+	for _, txn := range bk.Transactions {
+		if !txn.AppAccountAddress.Equals(address) || !filters.Match(txn) {
+			continue
+		}
+		summaries = append(summaries, bankexported.TransactionSummary{
+			Type:        txn.Type,
+			CommunityID: txn.CommunityID,
+			Count:       1,
+			Amount:      txn.Amount,
+		})
+	}
+	return summaries
+}
 
+func (bk *bankKeeper) EarnedCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Int {
+	earned := sdk.ZeroInt()
+	for _, txn := range bk.Transactions {
+		if !txn.AppAccountAddress.Equals(address) {
+			continue
+		}
+		switch {
+		case txn.Type.OneOf(bankexported.AllowedTransactionsForEarning):
+			earned = earned.Add(txn.Amount.Amount)
+		case txn.Type.OneOf(bankexported.AllowedTransactionsForEarningDeduction):
+			earned = earned.Sub(txn.Amount.Amount)
+		}
+	}
+	return earned
 }
 
 func mockDB(t *testing.T) (sdk.Context, Keeper) {
@@ -98,8 +131,8 @@ func mockDB(t *testing.T) (sdk.Context, Keeper) {
 	totalSupply := initCoins
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
 
-	bankKeeper := bankKeeper{
-		Transactions: []transaction{},
+	bankKeeper := &bankKeeper{
+		Transactions: []bankexported.Transaction{},
 	}
 	authKeeper := NewKeeper(authKey, paramsKeeper.Subspace(ModuleName), codec, bankKeeper, accountKeeper, supplyKeeper)
 
@@ -132,3 +165,23 @@ func getFakeKeyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
 	addr := sdk.AccAddress(pub.Address())
 	return key, pub, addr
 }
+
+func signTx(t *testing.T, ctx sdk.Context, keeper Keeper, privateKey crypto.PrivKey, fee auth.StdFee, msgs ...sdk.Msg) auth.StdTx {
+	return signTxBy(t, ctx, keeper, []crypto.PrivKey{privateKey}, fee, msgs...)
+}
+
+// signTxBy signs a transaction with each of the keys, in signer order
+func signTxBy(t *testing.T, ctx sdk.Context, keeper Keeper, privateKeys []crypto.PrivKey, fee auth.StdFee, msgs ...sdk.Msg) auth.StdTx {
+	signatures := make([]auth.StdSignature, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		var accountNumber, sequence uint64
+		if acc := keeper.accountKeeper.GetAccount(ctx, sdk.AccAddress(privateKey.PubKey().Address())); acc != nil {
+			accountNumber, sequence = acc.GetAccountNumber(), acc.GetSequence()
+		}
+		signature, err := privateKey.Sign(auth.StdSignBytes(ctx.ChainID(), accountNumber, sequence, fee, msgs, ""))
+		require.NoError(t, err)
+		signatures = append(signatures, auth.StdSignature{PubKey: privateKey.PubKey(), Signature: signature})
+	}
+
+	return auth.NewStdTx(msgs, fee, signatures, "")
+}
@@ -0,0 +1,81 @@
+package account
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeactivateAccount stops an AppAccount from creating claims, arguments, stakes and slashes.
+// Its active stakes run out normally, after which the staking module closes it.
+func (k Keeper) DeactivateAccount(ctx sdk.Context, primary sdk.AccAddress) (AppAccount, sdk.Error) {
+	user, err := k.managedAccount(ctx, primary)
+	if err != nil {
+		return user, err
+	}
+	user.DeactivatedTime = ctx.BlockHeader().Time
+	k.setAppAccount(ctx, user)
+	k.store(ctx).Set(deactivatedAccountKey(user.Identity), user.Identity)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Deactivated %s", user.String()))
+
+	return user, nil
+}
+
+// IsDeactivated tells whether the AppAccount of an address is deactivated
+func (k Keeper) IsDeactivated(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error) {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return false, ErrAppAccountNotFound(address)
+	}
+	return user.IsDeactivated(), nil
+}
+
+// DeactivatedAccounts returns the deactivated AppAccounts that are not closed yet
+func (k Keeper) DeactivatedAccounts(ctx sdk.Context) []AppAccount {
+	accounts := make([]AppAccount, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), DeactivatedAccountPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		user, ok := k.getAppAccount(ctx, iterator.Value())
+		if ok {
+			accounts = append(accounts, user)
+		}
+	}
+	return accounts
+}
+
+// DeactivatedAccountsAfter returns up to limit deactivated AppAccounts that are not closed yet,
+// starting after the given identity, or from the first one when it is empty
+func (k Keeper) DeactivatedAccountsAfter(ctx sdk.Context, after sdk.AccAddress, limit int) []AppAccount {
+	accounts := make([]AppAccount, 0)
+	start := DeactivatedAccountPrefix
+	if len(after) > 0 {
+		// smallest key strictly after the given identity
+		start = append(deactivatedAccountKey(after), 0x00)
+	}
+	iterator := k.store(ctx).Iterator(start, sdk.PrefixEndBytes(DeactivatedAccountPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid() && len(accounts) < limit; iterator.Next() {
+		user, ok := k.getAppAccount(ctx, iterator.Value())
+		if ok {
+			accounts = append(accounts, user)
+		}
+	}
+	return accounts
+}
+
+// CloseAccount marks a deactivated AppAccount closed, hiding it from queries
+func (k Keeper) CloseAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return ErrAppAccountNotFound(address)
+	}
+	user.Closed = true
+	k.setAppAccount(ctx, user)
+	k.store(ctx).Delete(deactivatedAccountKey(user.Identity))
+
+	k.Logger(ctx).Info(fmt.Sprintf("Closed %s", user.String()))
+
+	return nil
+}
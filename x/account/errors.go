@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -10,8 +11,21 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	ErrorCodeAppAccountNotFound     sdk.CodeType = 201
-	ErrorCodeAppAccountCreateFailed sdk.CodeType = 202
+	ErrorCodeAppAccountNotFound       sdk.CodeType = 201
+	ErrorCodeAppAccountCreateFailed   sdk.CodeType = 202
+	ErrorCodeAddressNotLinked         sdk.CodeType = 203
+	ErrorCodeNotPrimaryAddress        sdk.CodeType = 204
+	ErrorCodeAddressAlreadyLinked     sdk.CodeType = 205
+	ErrorCodeCannotRemovePrimary      sdk.CodeType = 206
+	ErrorCodeSelfRegistrationDisabled sdk.CodeType = 207
+	ErrorCodeInvalidRegistrationProof sdk.CodeType = 208
+	ErrorCodeRegistrationCapReached   sdk.CodeType = 209
+	ErrorCodeNotEnoughEarnedToInvite  sdk.CodeType = 210
+	ErrorCodeInviteLimitReached       sdk.CodeType = 211
+	ErrorCodeInviteCodeNotFound       sdk.CodeType = 212
+	ErrorCodeInviteCodeRedeemed       sdk.CodeType = 213
+	ErrorCodeAccountDeactivated       sdk.CodeType = 214
+	ErrorCodeRateLimitExceeded        sdk.CodeType = 215
 )
 
 // ErrAppAccountNotFound throws an error when the searched AppAccount is not found
@@ -23,3 +37,68 @@ func ErrAppAccountNotFound(address sdk.AccAddress) sdk.Error {
 func ErrAppAccountCreateFailed(address sdk.AccAddress) sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeAppAccountCreateFailed, fmt.Sprintf("Creating AppAccount failed: %s", address))
 }
+
+// ErrAddressNotLinked throws an error when an address is not linked to the AppAccount
+func ErrAddressNotLinked(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAddressNotLinked, fmt.Sprintf("Address is not linked to the AppAccount: %s", address))
+}
+
+// ErrNotPrimaryAddress throws an error when an address other than the primary one manages the AppAccount
+func ErrNotPrimaryAddress(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotPrimaryAddress, fmt.Sprintf("Address is not the primary address of an AppAccount: %s", address))
+}
+
+// ErrAddressAlreadyLinked throws an error when an address already belongs to an AppAccount
+func ErrAddressAlreadyLinked(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAddressAlreadyLinked, fmt.Sprintf("Address already belongs to an AppAccount: %s", address))
+}
+
+// ErrCannotRemovePrimary throws an error when removing the primary address of an AppAccount
+func ErrCannotRemovePrimary(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeCannotRemovePrimary, fmt.Sprintf("Primary address cannot be removed: %s", address))
+}
+
+// ErrSelfRegistrationDisabled throws an error when registering without the registrar is not allowed
+func ErrSelfRegistrationDisabled() sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeSelfRegistrationDisabled, "Self registration is disabled")
+}
+
+// ErrInvalidRegistrationProof throws an error when a self registration carries neither a valid voucher nor puzzle solution
+func ErrInvalidRegistrationProof(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidRegistrationProof, fmt.Sprintf("Invalid registration proof: %s", msg))
+}
+
+// ErrRegistrationCapReached throws an error when the self registrations of the block or day are used up
+func ErrRegistrationCapReached(period string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeRegistrationCapReached, fmt.Sprintf("Self registration cap reached for this %s", period))
+}
+
+// ErrNotEnoughEarnedToInvite throws an error when a user has not earned enough to mint invite codes
+func ErrNotEnoughEarnedToInvite(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotEnoughEarnedToInvite, fmt.Sprintf("Not enough earned to mint invite codes: %s", address))
+}
+
+// ErrInviteLimitReached throws an error when a user has minted all the invite codes allowed
+func ErrInviteLimitReached(limit int) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInviteLimitReached, fmt.Sprintf("Cannot mint more than %d invite codes", limit))
+}
+
+// ErrInviteCodeNotFound throws an error when the invite code does not exist
+func ErrInviteCodeNotFound(code string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInviteCodeNotFound, fmt.Sprintf("Invite code not found: %s", code))
+}
+
+// ErrInviteCodeRedeemed throws an error when the invite code was already used
+func ErrInviteCodeRedeemed(code string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInviteCodeRedeemed, fmt.Sprintf("Invite code already redeemed: %s", code))
+}
+
+// ErrAccountDeactivated throws an error when the AppAccount is already deactivated
+func ErrAccountDeactivated(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAccountDeactivated, fmt.Sprintf("AppAccount is deactivated: %s", address))
+}
+
+// ErrRateLimitExceeded throws an error when an account sent too many messages of a type
+func ErrRateLimitExceeded(msgType string, limit int, period time.Duration) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeRateLimitExceeded, fmt.Sprintf("Rate limit of %d %s messages per %s exceeded", limit, msgType, period))
+}
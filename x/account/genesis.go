@@ -9,15 +9,19 @@ import (
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	AppAccounts []AppAccount `json:"app_accounts"`
-	Params      Params       `json:"params"`
+	AppAccounts  []AppAccount  `json:"app_accounts"`
+	JailEpisodes []JailEpisode `json:"jail_episodes"`
+	InviteCodes  []InviteCode  `json:"invite_codes"`
+	Params       Params        `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState() GenesisState {
 	return GenesisState{
-		AppAccounts: nil,
-		Params:      DefaultParams(),
+		AppAccounts:  nil,
+		JailEpisodes: []JailEpisode{},
+		InviteCodes:  []InviteCode{},
+		Params:       DefaultParams(),
 	}
 }
 
@@ -27,12 +31,36 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 // InitGenesis initializes account state from genesis file
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, acc := range data.AppAccounts {
+		// accounts exported before identities were recorded are known by their primary address
+		if len(acc.Identity) == 0 {
+			acc.Identity = acc.PrimaryAddress()
+		}
+		// slashes exported before their time was recorded start decaying at genesis
+		if acc.SlashCount > 0 && acc.LastSlashTime.IsZero() {
+			acc.LastSlashTime = ctx.BlockHeader().Time
+		}
 		keeper.setAppAccount(ctx, acc)
+		for _, address := range acc.Addresses {
+			keeper.setLinkedAddress(ctx, address, acc.Identity)
+		}
 		if acc.IsJailed {
-			keeper.setJailEndTimeAccount(ctx, acc.JailEndTime, acc.PrimaryAddress())
+			keeper.setJailEndTimeAccount(ctx, acc.JailEndTime, acc.Identity)
+		}
+		if acc.IsDeactivated() && !acc.Closed {
+			keeper.store(ctx).Set(deactivatedAccountKey(acc.Identity), acc.Identity)
+		}
+	}
+	for _, episode := range data.JailEpisodes {
+		keeper.setJailEpisode(ctx, episode)
+	}
+	for _, invite := range data.InviteCodes {
+		keeper.setInviteCode(ctx, invite)
+		keeper.store(ctx).Set(userInviteCodeKey(invite.Inviter, invite.Code), []byte{})
+		if invite.Redeemed() && !invite.Rewarded {
+			keeper.store(ctx).Set(pendingReferralKey(invite.Code), []byte{})
 		}
 	}
-	keeper.SetParams(ctx, data.Params)
+	keeper.SetParams(ctx, withDefaultParams(data.Params))
 
 	err := initUserGrowthPool(ctx, keeper)
 	if err != nil {
@@ -40,6 +68,61 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	}
 }
 
+// withDefaultParams fills the params missing from a genesis exported before they existed
+func withDefaultParams(p Params) Params {
+	defaults := DefaultParams()
+	if p.JailEscalationFactor == 0 {
+		p.JailEscalationFactor = defaults.JailEscalationFactor
+	}
+	if p.MaxJailDuration == 0 {
+		p.MaxJailDuration = defaults.MaxJailDuration
+		if p.MaxJailDuration < p.JailDuration {
+			p.MaxJailDuration = p.JailDuration
+		}
+	}
+	if p.RegistrationDifficulty == 0 {
+		p.RegistrationDifficulty = defaults.RegistrationDifficulty
+	}
+	// the caps arrived together with the grant, explicitly zeroed caps are kept
+	if p.SelfRegistrationGrant.Denom == "" {
+		p.SelfRegistrationGrant = defaults.SelfRegistrationGrant
+		if p.RegistrationsPerBlock == 0 && p.RegistrationsPerDay == 0 {
+			p.RegistrationsPerBlock = defaults.RegistrationsPerBlock
+			p.RegistrationsPerDay = defaults.RegistrationsPerDay
+		}
+	}
+	// the invite code cap arrived together with the earned minimum, an explicitly zeroed cap is kept
+	if p.InviteMinEarned == (sdk.Int{}) {
+		p.InviteMinEarned = defaults.InviteMinEarned
+		if p.MaxInviteCodes == 0 {
+			p.MaxInviteCodes = defaults.MaxInviteCodes
+		}
+	}
+	if p.ReferralThreshold == (sdk.Int{}) {
+		p.ReferralThreshold = defaults.ReferralThreshold
+	}
+	if p.ReferralReward.Denom == "" {
+		p.ReferralReward = defaults.ReferralReward
+	}
+	if p.MaxReferralChecksPerBlock == 0 {
+		p.MaxReferralChecksPerBlock = defaults.MaxReferralChecksPerBlock
+	}
+	if p.ReferralExpiry == 0 {
+		p.ReferralExpiry = defaults.ReferralExpiry
+	}
+	// an explicitly emptied list turns fee exemptions or rate limits off and is kept
+	if p.FeeExemptMsgTypes == nil {
+		p.FeeExemptMsgTypes = defaults.FeeExemptMsgTypes
+	}
+	if p.RateLimitPeriod == 0 {
+		p.RateLimitPeriod = defaults.RateLimitPeriod
+	}
+	if p.MsgRateLimits == nil {
+		p.MsgRateLimits = defaults.MsgRateLimits
+	}
+	return p
+}
+
 func initUserGrowthPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 	userGrowthAcc := keeper.supplyKeeper.GetModuleAccount(ctx, UserGrowthPoolName)
 	if userGrowthAcc.GetCoins().Empty() {
@@ -55,13 +138,16 @@ func initUserGrowthPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	return GenesisState{
-		AppAccounts: keeper.AppAccounts(ctx),
-		Params:      keeper.GetParams(ctx),
+		AppAccounts:  keeper.AppAccounts(ctx),
+		JailEpisodes: keeper.JailEpisodes(ctx),
+		InviteCodes:  keeper.InviteCodes(ctx),
+		Params:       keeper.GetParams(ctx),
 	}
 }
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
+	data.Params = withDefaultParams(data.Params)
 	if len(data.Params.Registrar) == 0 {
 		return fmt.Errorf("Param: Registrar, must be a valid address")
 	}
@@ -74,5 +160,59 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: JailTime, must have a positive value")
 	}
 
+	if data.Params.SlashDecayPeriod < 0 {
+		return fmt.Errorf("Param: SlashDecayPeriod, cannot be a negative value")
+	}
+
+	if data.Params.JailEscalationFactor < 1 {
+		return fmt.Errorf("Param: JailEscalationFactor, must have a positive value")
+	}
+
+	if data.Params.MaxJailDuration < data.Params.JailDuration {
+		return fmt.Errorf("Param: MaxJailDuration, cannot be shorter than JailTime")
+	}
+
+	if data.Params.RegistrationDifficulty < MinRegistrationDifficulty {
+		return fmt.Errorf("Param: RegistrationDifficulty, must be at least %d", MinRegistrationDifficulty)
+	}
+
+	if !data.Params.SelfRegistrationGrant.IsValid() {
+		return fmt.Errorf("Param: SelfRegistrationGrant, must be a valid coin")
+	}
+
+	if data.Params.RegistrationsPerBlock < 0 || data.Params.RegistrationsPerDay < 0 {
+		return fmt.Errorf("Param: RegistrationsPerBlock and RegistrationsPerDay, cannot be negative values")
+	}
+
+	if data.Params.InviteMinEarned == (sdk.Int{}) || data.Params.InviteMinEarned.IsNegative() {
+		return fmt.Errorf("Param: InviteMinEarned, must be set and cannot be a negative value")
+	}
+
+	if data.Params.ReferralThreshold == (sdk.Int{}) || data.Params.ReferralThreshold.IsNegative() {
+		return fmt.Errorf("Param: ReferralThreshold, must be set and cannot be a negative value")
+	}
+
+	if data.Params.MaxInviteCodes < 0 {
+		return fmt.Errorf("Param: MaxInviteCodes, cannot be a negative value")
+	}
+
+	if !data.Params.ReferralReward.IsValid() {
+		return fmt.Errorf("Param: ReferralReward, must be a valid coin")
+	}
+
+	if data.Params.ReferralExpiry < 0 || data.Params.MaxReferralChecksPerBlock < 0 {
+		return fmt.Errorf("Param: ReferralExpiry and MaxReferralChecksPerBlock, cannot be negative values")
+	}
+
+	if data.Params.RateLimitPeriod.Seconds() < 1 {
+		return fmt.Errorf("Param: RateLimitPeriod, must have a positive value")
+	}
+
+	for _, limit := range data.Params.MsgRateLimits {
+		if limit.Limit < 0 {
+			return fmt.Errorf("Param: MsgRateLimits, limit of %s cannot be a negative value", limit.MsgType)
+		}
+	}
+
 	return nil
 }
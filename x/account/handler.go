@@ -14,6 +14,18 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgRegisterKey(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgAddKey:
+			return handleMsgAddKey(ctx, keeper, msg)
+		case MsgRemoveKey:
+			return handleMsgRemoveKey(ctx, keeper, msg)
+		case MsgPromoteKey:
+			return handleMsgPromoteKey(ctx, keeper, msg)
+		case MsgSelfRegister:
+			return handleMsgSelfRegister(ctx, keeper, msg)
+		case MsgCreateInviteCode:
+			return handleMsgCreateInviteCode(ctx, keeper, msg)
+		case MsgDeactivateAccount:
+			return handleMsgDeactivateAccount(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized auth message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -30,6 +42,11 @@ func handleMsgRegisterKey(ctx sdk.Context, k Keeper, msg MsgRegisterKey) sdk.Res
 	if err != nil {
 		return err.Result()
 	}
+	if msg.InviteCode != "" {
+		if err := k.RedeemInviteCode(ctx, msg.InviteCode, msg.Address); err != nil {
+			return err.Result()
+		}
+	}
 
 	res, jsonErr := k.codec.MarshalJSON(appAccount)
 	if jsonErr != nil {
@@ -61,3 +78,128 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgAddKey(ctx sdk.Context, k Keeper, msg MsgAddKey) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.AddKey(ctx, msg.Creator, msg.Address)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgRemoveKey(ctx sdk.Context, k Keeper, msg MsgRemoveKey) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.RemoveKey(ctx, msg.Creator, msg.Address)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgPromoteKey(ctx sdk.Context, k Keeper, msg MsgPromoteKey) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.PromoteKey(ctx, msg.Creator, msg.Address)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgSelfRegister(ctx sdk.Context, k Keeper, msg MsgSelfRegister) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.SelfRegister(ctx, msg.Address, msg.PubKey, msg.Nonce, msg.Voucher)
+	if err != nil {
+		return err.Result()
+	}
+	if msg.InviteCode != "" {
+		if err := k.RedeemInviteCode(ctx, msg.InviteCode, msg.Address); err != nil {
+			return err.Result()
+		}
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgCreateInviteCode(ctx sdk.Context, k Keeper, msg MsgCreateInviteCode) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	invite, err := k.CreateInviteCode(ctx, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(invite)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgDeactivateAccount(ctx sdk.Context, k Keeper, msg MsgDeactivateAccount) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appAccount, err := k.DeactivateAccount(ctx, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := k.codec.MarshalJSON(appAccount)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
@@ -39,3 +39,25 @@ func TestByzantineMsg(t *testing.T) {
 	assert.Equal(t, sdk.CodeUnknownRequest, res.Code)
 	assert.Equal(t, sdk.CodespaceRoot, res.Codespace)
 }
+
+func TestHandleMsgAddKey(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	handler := NewHandler(keeper)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, _, secondAddress := getFakeKeyPubAddr()
+
+	result := handler(ctx, NewMsgAddKey(address, secondAddress))
+	assert.True(t, result.IsOK())
+	var appAccount AppAccount
+	err = keeper.codec.UnmarshalJSON(result.Data, &appAccount)
+	assert.NoError(t, err)
+	assert.True(t, appAccount.HasAddress(secondAddress))
+
+	result = handler(ctx, NewMsgPromoteKey(address, secondAddress))
+	assert.True(t, result.IsOK())
+	result = handler(ctx, NewMsgRemoveKey(address, secondAddress))
+	assert.Equal(t, ErrNotPrimaryAddress(address).Code(), result.Code)
+}
@@ -0,0 +1,54 @@
+package account
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all account invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "jailed-accounts", JailedAccountsInvariant(k))
+}
+
+// JailedAccountsInvariant checks that every jailed account has exactly one entry
+// in the jail end time index and that the index holds no other entries
+func JailedAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		jailed := make(map[string]AppAccount)
+		k.IterateAppAccounts(ctx, func(acc AppAccount) bool {
+			if acc.IsJailed {
+				jailed[acc.Identity.String()] = acc
+			}
+			return false
+		})
+
+		indexed := 0
+		iterator := sdk.KVStorePrefixIterator(k.store(ctx), JailEndTimeAccountPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			indexed++
+			addr := sdk.AccAddress(iterator.Value())
+			acc, ok := jailed[addr.String()]
+			if !ok {
+				count++
+				msg += fmt.Sprintf("\t%s is in the jail index but not jailed\n", addr)
+				continue
+			}
+			if !bytes.Equal(iterator.Key(), jailEndTimeAccountKey(acc.JailEndTime, addr)) {
+				count++
+				msg += fmt.Sprintf("\t%s is indexed under a jail end time other than %s\n", addr, acc.JailEndTime)
+			}
+		}
+		if indexed != len(jailed) {
+			count++
+			msg += fmt.Sprintf("\t%d jailed accounts, %d jail index entries\n", len(jailed), indexed)
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "jailed-accounts",
+			fmt.Sprintf("%d jail index inconsistencies\n%s", count, msg)), broken
+	}
+}
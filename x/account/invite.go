@@ -0,0 +1,223 @@
+package account
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	bankexported "github.com/ahmedaly113/ahchain/x/bank/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InviteCode is a code an existing user hands out to register a new one.
+// The inviter is rewarded once the invitee has earned ReferralThreshold.
+type InviteCode struct {
+	Code         string         `json:"code"`
+	Inviter      sdk.AccAddress `json:"inviter"`
+	Invitee      sdk.AccAddress `json:"invitee"`
+	CreatedTime  time.Time      `json:"created_time"`
+	RedeemedTime time.Time      `json:"redeemed_time"`
+	Rewarded     bool           `json:"rewarded"`
+	RewardedTime time.Time      `json:"rewarded_time"`
+}
+
+// Redeemed tells whether a new user registered with the code
+func (i InviteCode) Redeemed() bool {
+	return len(i.Invitee) > 0
+}
+
+// CreateInviteCode mints an invite code for a user who earned at least InviteMinEarned,
+// up to MaxInviteCodes per user
+func (k Keeper) CreateInviteCode(ctx sdk.Context, creator sdk.AccAddress) (InviteCode, sdk.Error) {
+	params := k.GetParams(ctx)
+	inviter, err := k.Identity(ctx, creator)
+	if err != nil {
+		return InviteCode{}, err
+	}
+	user, ok := k.getAppAccount(ctx, inviter)
+	if !ok {
+		return InviteCode{}, ErrAppAccountNotFound(creator)
+	}
+	if user.IsDeactivated() {
+		return InviteCode{}, ErrAccountDeactivated(creator)
+	}
+	if k.earnedCoins(ctx, inviter).LT(params.InviteMinEarned) {
+		return InviteCode{}, ErrNotEnoughEarnedToInvite(creator)
+	}
+	minted := len(k.UserInviteCodes(ctx, inviter))
+	if minted >= params.MaxInviteCodes {
+		return InviteCode{}, ErrInviteLimitReached(params.MaxInviteCodes)
+	}
+
+	invite := InviteCode{
+		Code:        k.newInviteCode(ctx, inviter, minted),
+		Inviter:     inviter,
+		CreatedTime: ctx.BlockHeader().Time,
+	}
+	k.setInviteCode(ctx, invite)
+	k.store(ctx).Set(userInviteCodeKey(inviter, invite.Code), []byte{})
+
+	k.Logger(ctx).Info(fmt.Sprintf("Minted invite code %s for %s", invite.Code, inviter))
+
+	return invite, nil
+}
+
+// newInviteCode derives a code from the inviter and the number of codes they minted.
+// Codes are truncated hashes, so on a collision with an existing code a nonce is added until the code is unused.
+func (k Keeper) newInviteCode(ctx sdk.Context, inviter sdk.AccAddress, minted int) string {
+	seed := append(inviter.Bytes(), sdk.Uint64ToBigEndian(uint64(minted))...)
+	for nonce := uint64(0); ; nonce++ {
+		hash := sha256.Sum256(seed)
+		if nonce > 0 {
+			hash = sha256.Sum256(append(seed, sdk.Uint64ToBigEndian(nonce)...))
+		}
+		code := hex.EncodeToString(hash[:6])
+		if _, taken := k.InviteCode(ctx, code); !taken {
+			return code
+		}
+	}
+}
+
+// RedeemInviteCode records a newly registered user as the invitee of a code
+func (k Keeper) RedeemInviteCode(ctx sdk.Context, code string, invitee sdk.AccAddress) sdk.Error {
+	invite, ok := k.InviteCode(ctx, code)
+	if !ok {
+		return ErrInviteCodeNotFound(code)
+	}
+	if invite.Redeemed() {
+		return ErrInviteCodeRedeemed(code)
+	}
+	invitee, err := k.Identity(ctx, invitee)
+	if err != nil {
+		return err
+	}
+	invite.Invitee = invitee
+	invite.RedeemedTime = ctx.BlockHeader().Time
+	k.setInviteCode(ctx, invite)
+	k.store(ctx).Set(pendingReferralKey(code), []byte{})
+
+	return nil
+}
+
+// InviteCode returns an invite by its code
+func (k Keeper) InviteCode(ctx sdk.Context, code string) (invite InviteCode, ok bool) {
+	bz := k.store(ctx).Get(inviteCodeKey(code))
+	if bz == nil {
+		return
+	}
+	k.codec.MustUnmarshalBinaryBare(bz, &invite)
+	return invite, true
+}
+
+// UserInviteCodes returns the invite codes minted by a user
+func (k Keeper) UserInviteCodes(ctx sdk.Context, address sdk.AccAddress) []InviteCode {
+	invites := make([]InviteCode, 0)
+	prefix := userInviteCodesKey(address)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		invite, ok := k.InviteCode(ctx, string(iterator.Key()[len(prefix):]))
+		if ok {
+			invites = append(invites, invite)
+		}
+	}
+	return invites
+}
+
+// InviteCodes returns all invite codes
+func (k Keeper) InviteCodes(ctx sdk.Context) []InviteCode {
+	invites := make([]InviteCode, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), InviteCodePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var invite InviteCode
+		k.codec.MustUnmarshalBinaryBare(iterator.Value(), &invite)
+		invites = append(invites, invite)
+	}
+	return invites
+}
+
+// ReferralEarnings returns the referral rewards paid to a user
+func (k Keeper) ReferralEarnings(ctx sdk.Context, address sdk.AccAddress) sdk.Coin {
+	earnings := sdk.NewCoin(k.GetParams(ctx).ReferralReward.Denom, sdk.ZeroInt())
+	summaries := k.bankKeeper.TransactionSummary(ctx, address,
+		bankexported.FilterByTransactionType(TransactionReferralReward))
+	for _, summary := range summaries {
+		earnings = earnings.Add(summary.Amount)
+	}
+	return earnings
+}
+
+// payReferralRewards rewards the inviters whose invitees earned ReferralThreshold.
+// At most MaxReferralChecksPerBlock pending referrals are checked per block, the next
+// block resumes after the last one checked. Referrals still pending ReferralExpiry
+// after the code was redeemed are dropped.
+func (k Keeper) payReferralRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	store := k.store(ctx)
+	now := ctx.BlockHeader().Time
+
+	start := PendingReferralPrefix
+	if cursor := store.Get(PendingReferralCursorKey); cursor != nil {
+		// smallest key strictly after the cursor
+		start = append(pendingReferralKey(string(cursor)), 0x00)
+	}
+	codes := make([]string, 0)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(PendingReferralPrefix))
+	for ; iterator.Valid() && len(codes) < params.MaxReferralChecksPerBlock; iterator.Next() {
+		codes = append(codes, string(iterator.Key()[len(PendingReferralPrefix):]))
+	}
+	exhausted := !iterator.Valid()
+	iterator.Close()
+	if exhausted || len(codes) == 0 {
+		store.Delete(PendingReferralCursorKey)
+	} else {
+		store.Set(PendingReferralCursorKey, []byte(codes[len(codes)-1]))
+	}
+
+	for _, code := range codes {
+		invite, ok := k.InviteCode(ctx, code)
+		if !ok {
+			store.Delete(pendingReferralKey(code))
+			continue
+		}
+		if params.ReferralExpiry > 0 && now.After(invite.RedeemedTime.Add(params.ReferralExpiry)) {
+			k.Logger(ctx).Info(fmt.Sprintf("Referral of %s expired", invite.Code))
+			store.Delete(pendingReferralKey(code))
+			continue
+		}
+		if k.earnedCoins(ctx, invite.Invitee).LT(params.ReferralThreshold) {
+			continue
+		}
+		_, err := k.bankKeeper.AddCoin(ctx, invite.Inviter, params.ReferralReward, 0,
+			TransactionReferralReward, FromModuleAccount(UserGrowthPoolName))
+		if err != nil {
+			// the pool may be refilled by inflation, try again next block
+			k.Logger(ctx).Error(fmt.Sprintf("Referral reward for %s failed: %s", invite.Code, err))
+			continue
+		}
+		invite.Rewarded = true
+		invite.RewardedTime = ctx.BlockHeader().Time
+		k.setInviteCode(ctx, invite)
+		store.Delete(pendingReferralKey(code))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeReferralRewarded,
+				sdk.NewAttribute(AttributeKeyUser, invite.Inviter.String()),
+				sdk.NewAttribute(AttributeKeyInvitee, invite.Invitee.String()),
+			),
+		)
+	}
+}
+
+// earnedCoins returns the lifetime earnings minus earning deductions of a user,
+// read from the running total the bank keeps so end block checks stay cheap
+func (k Keeper) earnedCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Int {
+	return k.bankKeeper.EarnedCoins(ctx, address)
+}
+
+func (k Keeper) setInviteCode(ctx sdk.Context, invite InviteCode) {
+	k.store(ctx).Set(inviteCodeKey(invite.Code), k.codec.MustMarshalBinaryBare(invite))
+}
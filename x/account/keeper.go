@@ -43,6 +43,10 @@ func NewKeeper(storeKey sdk.StoreKey, paramStore params.Subspace, codec *codec.C
 func (k Keeper) CreateAppAccount(ctx sdk.Context, address sdk.AccAddress,
 	coins sdk.Coins, pubKey crypto.PubKey) (appAccnt AppAccount, sdkErr sdk.Error) {
 
+	if _, ok := k.getAppAccount(ctx, address); ok {
+		return appAccnt, ErrAddressAlreadyLinked(address)
+	}
+
 	// first create a base account
 	baseAccount := auth.NewBaseAccountWithAddress(address)
 	err := baseAccount.SetPubKey(pubKey)
@@ -54,6 +58,7 @@ func (k Keeper) CreateAppAccount(ctx sdk.Context, address sdk.AccAddress,
 	//  then create an app account
 	appAccnt = NewAppAccount(address, ctx.BlockHeader().Time)
 	k.setAppAccount(ctx, appAccnt)
+	k.setLinkedAddress(ctx, address, appAccnt.Identity)
 
 	// set initial coins
 	initialCoinAmount := coins.AmountOf(app.StakeDenom)
@@ -72,6 +77,11 @@ func (k Keeper) CreateAppAccount(ctx sdk.Context, address sdk.AccAddress,
 	return appAccnt, nil
 }
 
+// AppAccount returns the app account an address is linked to
+func (k Keeper) AppAccount(ctx sdk.Context, addr sdk.AccAddress) (AppAccount, bool) {
+	return k.getAppAccount(ctx, addr)
+}
+
 // AppAccounts returns all app accounts
 func (k Keeper) AppAccounts(ctx sdk.Context) (appAccounts []AppAccount) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), AppAccountKeyPrefix)
@@ -103,6 +113,7 @@ func (k Keeper) PrimaryAccount(ctx sdk.Context, addr sdk.AccAddress) (pAcc Prima
 			Sequence:      acc.GetSequence(),
 		},
 		SlashCount:  appAcc.SlashCount,
+		JailCount:   appAcc.JailCount,
 		IsJailed:    appAcc.IsJailed,
 		JailEndTime: appAcc.JailEndTime,
 		CreatedTime: appAcc.CreatedTime,
@@ -136,7 +147,7 @@ func (k Keeper) JailUntil(ctx sdk.Context, address sdk.AccAddress, until time.Ti
 
 	// delete previous jail time
 	if user.IsJailed {
-		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Addresses[0])
+		k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Identity)
 	}
 	user.IsJailed = true
 	user.JailEndTime = until
@@ -144,21 +155,30 @@ func (k Keeper) JailUntil(ctx sdk.Context, address sdk.AccAddress, until time.Ti
 	k.setAppAccount(ctx, user)
 
 	// persist in jail list (sorted by jail end time)
-	k.setJailEndTimeAccount(ctx, until, address)
+	k.setJailEndTimeAccount(ctx, until, user.Identity)
 
 	return nil
 }
 
-// UnJail unjails an AppAccount.
+// UnJail unjails an AppAccount. The slash count is reset on release,
+// the slashes that led to the jail were served with it.
 func (k Keeper) UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 	user, ok := k.getAppAccount(ctx, address)
 	if !ok {
 		return ErrAppAccountNotFound(address)
 	}
 	user.IsJailed = false
-	k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Addresses[0])
+	user.SlashCount = 0
+	k.deleteJailEndTimeAccount(ctx, user.JailEndTime, user.Identity)
 	k.setAppAccount(ctx, user)
 
+	// close the current jail episode
+	episode, ok := k.JailEpisode(ctx, user.Identity, user.JailCount)
+	if ok && episode.ReleasedTime.IsZero() {
+		episode.ReleasedTime = ctx.BlockHeader().Time
+		k.setJailEpisode(ctx, episode)
+	}
+
 	return nil
 }
 
@@ -172,19 +192,38 @@ func (k Keeper) IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Err
 	return user.IsJailed, nil
 }
 
-// IncrementSlashCount increments the slash count of the user
-func (k Keeper) IncrementSlashCount(ctx sdk.Context, address sdk.AccAddress) (jailed bool, err sdk.Error) {
+// CurrentJailEpisode returns the number of the jail episode a user is serving, zero when not jailed
+func (k Keeper) CurrentJailEpisode(ctx sdk.Context, address sdk.AccAddress) (int, sdk.Error) {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return 0, ErrAppAccountNotFound(address)
+	}
+	if !user.IsJailed {
+		return 0, nil
+	}
+	// accounts jailed before episodes were recorded serve their first one
+	if user.JailCount == 0 {
+		return 1, nil
+	}
+
+	return user.JailCount, nil
+}
+
+// IncrementSlashCount increments the slash count of the user for a slash
+// and jails them once it reaches MaxSlashCount
+func (k Keeper) IncrementSlashCount(ctx sdk.Context, address sdk.AccAddress, slashID uint64, reason string) (jailed bool, err sdk.Error) {
 	user, ok := k.getAppAccount(ctx, address)
 	if !ok {
 		return false, ErrAppAccountNotFound(address)
 	}
 
 	user.SlashCount++
+	user.LastSlashTime = ctx.BlockHeader().Time
 	k.setAppAccount(ctx, user)
+	k.setPendingSlash(ctx, user.Identity, SlashRecord{SlashID: slashID, Reason: reason})
 
 	if user.SlashCount >= k.GetParams(ctx).MaxSlashCount {
-		jailEndTime := ctx.BlockHeader().Time.Add(k.GetParams(ctx).JailDuration)
-		err := k.JailUntil(ctx, user.Addresses[0], jailEndTime)
+		err := k.jail(ctx, user)
 		if err != nil {
 			return false, err
 		}
@@ -194,6 +233,126 @@ func (k Keeper) IncrementSlashCount(ctx sdk.Context, address sdk.AccAddress) (ja
 	return false, nil
 }
 
+// DecrementSlashCount decrements the slash count of the user for a reverted slash, it never goes below zero
+func (k Keeper) DecrementSlashCount(ctx sdk.Context, address sdk.AccAddress, slashID uint64) sdk.Error {
+	user, ok := k.getAppAccount(ctx, address)
+	if !ok {
+		return ErrAppAccountNotFound(address)
+	}
+	if user.SlashCount > 0 {
+		user.SlashCount--
+	}
+	k.setAppAccount(ctx, user)
+	k.store(ctx).Delete(pendingSlashKey(user.Identity, slashID))
+
+	return nil
+}
+
+// jail puts a user in jail and records the episode with the slashes that led to it.
+// Every previous episode multiplies JailDuration by JailEscalationFactor, up to MaxJailDuration.
+// Slashes while already jailed extend the current episode.
+func (k Keeper) jail(ctx sdk.Context, user AppAccount) sdk.Error {
+	now := ctx.BlockHeader().Time
+	address := user.Identity
+	slashes := k.popPendingSlashes(ctx, address)
+
+	episode, ok := k.JailEpisode(ctx, address, user.JailCount)
+	if !user.IsJailed || !ok {
+		user.JailCount++
+		k.setAppAccount(ctx, user)
+		episode = JailEpisode{
+			Address:   address,
+			Number:    user.JailCount,
+			Slashes:   make([]SlashRecord, 0),
+			StartTime: now,
+		}
+	}
+	episode.SlashCount = user.SlashCount
+	episode.Slashes = append(episode.Slashes, slashes...)
+	episode.EndTime = now.Add(k.jailDuration(ctx, episode.Number-1))
+	k.setJailEpisode(ctx, episode)
+
+	return k.JailUntil(ctx, address, episode.EndTime)
+}
+
+// jailDuration returns the jail duration after a number of previous jail episodes
+func (k Keeper) jailDuration(ctx sdk.Context, previousEpisodes int) time.Duration {
+	params := k.GetParams(ctx)
+	duration := params.JailDuration
+	for i := 0; i < previousEpisodes && params.JailEscalationFactor > 1; i++ {
+		duration *= time.Duration(params.JailEscalationFactor)
+		if duration >= params.MaxJailDuration {
+			return params.MaxJailDuration
+		}
+	}
+	return duration
+}
+
+// JailEpisode returns a jail episode of a user by its number
+func (k Keeper) JailEpisode(ctx sdk.Context, address sdk.AccAddress, number int) (episode JailEpisode, ok bool) {
+	bz := k.store(ctx).Get(jailEpisodeKey(address, number))
+	if bz == nil {
+		return episode, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &episode)
+	return episode, true
+}
+
+// JailHistory returns the jail episodes of a user, oldest first
+func (k Keeper) JailHistory(ctx sdk.Context, address sdk.AccAddress) []JailEpisode {
+	episodes := make([]JailEpisode, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), jailEpisodesKey(address))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var episode JailEpisode
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &episode)
+		episodes = append(episodes, episode)
+	}
+	return episodes
+}
+
+// JailEpisodes returns the jail episodes of all users
+func (k Keeper) JailEpisodes(ctx sdk.Context) []JailEpisode {
+	episodes := make([]JailEpisode, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), JailEpisodePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var episode JailEpisode
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &episode)
+		episodes = append(episodes, episode)
+	}
+	return episodes
+}
+
+func (k Keeper) setJailEpisode(ctx sdk.Context, episode JailEpisode) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(episode)
+	k.store(ctx).Set(jailEpisodeKey(episode.Address, episode.Number), bz)
+}
+
+func (k Keeper) setPendingSlash(ctx sdk.Context, address sdk.AccAddress, slash SlashRecord) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(slash)
+	k.store(ctx).Set(pendingSlashKey(address, slash.SlashID), bz)
+}
+
+// popPendingSlashes returns and removes the slashes counted since the last jail episode
+func (k Keeper) popPendingSlashes(ctx sdk.Context, address sdk.AccAddress) []SlashRecord {
+	store := k.store(ctx)
+	slashes := make([]SlashRecord, 0)
+	keys := make([][]byte, 0)
+	iterator := sdk.KVStorePrefixIterator(store, pendingSlashesKey(address))
+	for ; iterator.Valid(); iterator.Next() {
+		var slash SlashRecord
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &slash)
+		slashes = append(slashes, slash)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, bz := range keys {
+		store.Delete(bz)
+	}
+	return slashes
+}
+
 // IterateAppAccounts iterates over all the stored app accounts and performs a callback function
 func (k Keeper) IterateAppAccounts(ctx sdk.Context, cb func(acc AppAccount) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), AppAccountKeyPrefix)
@@ -209,19 +368,26 @@ func (k Keeper) IterateAppAccounts(ctx sdk.Context, cb func(acc AppAccount) (sto
 	}
 }
 
+// getAppAccount returns the app account an address is linked to, or was registered with,
+// with its slash count decayed to the current block time
 func (k Keeper) getAppAccount(ctx sdk.Context, addr sdk.AccAddress) (acc AppAccount, ok bool) {
-	accBytes := k.store(ctx).Get(key(addr))
+	identity := addr
+	if bz := k.store(ctx).Get(linkedAddressKey(addr)); bz != nil {
+		identity = bz
+	}
+	accBytes := k.store(ctx).Get(key(identity))
 	if accBytes == nil {
 		return
 	}
 	k.codec.MustUnmarshalBinaryBare(accBytes, &acc)
+	acc.decaySlashCount(k.GetParams(ctx).SlashDecayPeriod, ctx.BlockHeader().Time)
 
 	return acc, true
 }
 
 func (k Keeper) setAppAccount(ctx sdk.Context, acc AppAccount) {
 	accBytes := k.codec.MustMarshalBinaryBare(acc)
-	k.store(ctx).Set(key(acc.PrimaryAddress()), accBytes)
+	k.store(ctx).Set(key(acc.Identity), accBytes)
 }
 
 func (k Keeper) setJailEndTimeAccount(ctx sdk.Context, jailEndTime time.Time, addr sdk.AccAddress) {
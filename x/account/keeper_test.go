@@ -4,7 +4,13 @@ import (
 	"testing"
 	"time"
 
+	app "github.com/ahmedaly113/ahchain/types"
+	bankexported "github.com/ahmedaly113/ahchain/x/bank/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func TestNewAppAccount_Success(t *testing.T) {
@@ -64,6 +70,32 @@ func TestJailUntil_Success(t *testing.T) {
 
 }
 
+func TestJailedAccountsInvariant(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	createdAppAccount, _ := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	_, broken := JailedAccountsInvariant(keeper)(ctx)
+	assert.False(t, broken)
+
+	err := keeper.JailUntil(ctx, createdAppAccount.PrimaryAddress(), time.Now().AddDate(0, 0, 10))
+	assert.NoError(t, err)
+	err = keeper.JailUntil(ctx, createdAppAccount.PrimaryAddress(), time.Now().AddDate(0, 0, 20))
+	assert.NoError(t, err)
+	_, broken = JailedAccountsInvariant(keeper)(ctx)
+	assert.False(t, broken)
+
+	// stale entry left behind in the index
+	keeper.setJailEndTimeAccount(ctx, time.Now().AddDate(0, 0, 5), createdAppAccount.PrimaryAddress())
+	_, broken = JailedAccountsInvariant(keeper)(ctx)
+	assert.True(t, broken)
+
+	err = keeper.UnJail(ctx, createdAppAccount.PrimaryAddress())
+	assert.NoError(t, err)
+	_, broken = JailedAccountsInvariant(keeper)(ctx)
+	assert.True(t, broken)
+}
+
 func TestIncrementSlashCount_Success(t *testing.T) {
 	ctx, keeper := mockDB(t)
 
@@ -73,14 +105,595 @@ func TestIncrementSlashCount_Success(t *testing.T) {
 	assert.Equal(t, createdAppAccount.SlashCount, 0)
 
 	// incrementing once
-	keeper.IncrementSlashCount(ctx, createdAppAccount.PrimaryAddress())
+	keeper.IncrementSlashCount(ctx, createdAppAccount.PrimaryAddress(), 1, "Spam")
 	returnedAppAccount, ok := keeper.getAppAccount(ctx, address)
 	assert.True(t, ok)
 	assert.Equal(t, returnedAppAccount.SlashCount, 1)
 
 	// incrementing again
-	keeper.IncrementSlashCount(ctx, createdAppAccount.PrimaryAddress())
+	keeper.IncrementSlashCount(ctx, createdAppAccount.PrimaryAddress(), 2, "Spam")
 	returnedAppAccount, ok = keeper.getAppAccount(ctx, address)
 	assert.True(t, ok)
 	assert.Equal(t, returnedAppAccount.SlashCount, 2)
 }
+
+func TestSlashCountDecay(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	period := keeper.GetParams(ctx).SlashDecayPeriod
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, err = keeper.IncrementSlashCount(ctx, address, 1, "Spam")
+	assert.NoError(t, err)
+	_, err = keeper.IncrementSlashCount(ctx, address, 2, "Spam")
+	assert.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(period - time.Second))
+	account, err := keeper.PrimaryAccount(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, account.SlashCount)
+
+	ctx = ctx.WithBlockTime(now.Add(period + time.Second))
+	account, err = keeper.PrimaryAccount(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, account.SlashCount)
+
+	// old slashes are forgiven before a new one is counted
+	ctx = ctx.WithBlockTime(now.Add(period * 5))
+	jailed, err := keeper.IncrementSlashCount(ctx, address, 3, "Spam")
+	assert.NoError(t, err)
+	assert.False(t, jailed)
+	account, err = keeper.PrimaryAccount(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, account.SlashCount)
+}
+
+func TestInitGenesis_LegacySlashCount(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	_, _, address, _ := getFakeAppAccountParams()
+	account := NewAppAccount(address, now)
+	account.SlashCount = 2
+
+	genesis := DefaultGenesisState()
+	genesis.AppAccounts = []AppAccount{account}
+	InitGenesis(ctx, keeper, genesis)
+
+	imported, ok := keeper.getAppAccount(ctx, address)
+	assert.True(t, ok)
+	assert.True(t, now.Equal(imported.LastSlashTime))
+	assert.Equal(t, 2, imported.SlashCount)
+
+	// decay runs from genesis time
+	ctx = ctx.WithBlockTime(now.Add(keeper.GetParams(ctx).SlashDecayPeriod))
+	imported, _ = keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 1, imported.SlashCount)
+}
+
+func TestGenesis_LegacyParams(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	_, _, registrar, _ := getFakeAppAccountParams()
+	// params exported before jail escalation, self registration, invites and fee exemptions
+	legacy := Params{
+		Registrar:             registrar,
+		MaxSlashCount:         3,
+		JailDuration:          24 * time.Hour * 7,
+		UserGrowthAllocation:  sdk.NewDecWithPrec(20, 2),
+		StakeholderAllocation: sdk.NewDecWithPrec(20, 2),
+	}
+	genesis := DefaultGenesisState()
+	genesis.Params = legacy
+	assert.NoError(t, ValidateGenesis(genesis))
+
+	InitGenesis(ctx, keeper, genesis)
+	params := keeper.GetParams(ctx)
+	defaults := DefaultParams()
+	assert.Equal(t, defaults.JailEscalationFactor, params.JailEscalationFactor)
+	assert.Equal(t, defaults.MaxJailDuration, params.MaxJailDuration)
+	assert.Equal(t, legacy.JailDuration, params.JailDuration)
+	assert.Equal(t, defaults.RegistrationDifficulty, params.RegistrationDifficulty)
+	assert.True(t, defaults.SelfRegistrationGrant.IsEqual(params.SelfRegistrationGrant))
+	assert.Equal(t, defaults.RegistrationsPerBlock, params.RegistrationsPerBlock)
+	assert.True(t, defaults.InviteMinEarned.Equal(params.InviteMinEarned))
+	assert.Equal(t, defaults.MaxInviteCodes, params.MaxInviteCodes)
+	assert.True(t, defaults.ReferralThreshold.Equal(params.ReferralThreshold))
+	assert.True(t, defaults.ReferralReward.IsEqual(params.ReferralReward))
+	assert.Equal(t, defaults.FeeExemptMsgTypes, params.FeeExemptMsgTypes)
+	assert.Equal(t, defaults.RateLimitPeriod, params.RateLimitPeriod)
+	assert.Equal(t, defaults.MsgRateLimits, params.MsgRateLimits)
+
+	genesis = DefaultGenesisState()
+	genesis.Params.Registrar = registrar
+	assert.NoError(t, ValidateGenesis(genesis))
+	genesis.Params.ReferralThreshold = sdk.NewInt(-1)
+	assert.Error(t, ValidateGenesis(genesis))
+}
+
+func TestJailEscalation_History(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	params := keeper.GetParams(ctx)
+	params.MaxSlashCount = 1
+	params.SlashDecayPeriod = 0
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	jailed, err := keeper.IncrementSlashCount(ctx, address, 1, "Spam")
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+	account, _ := keeper.getAppAccount(ctx, address)
+	assert.True(t, now.Add(params.JailDuration).Equal(account.JailEndTime))
+
+	ctx = ctx.WithBlockTime(account.JailEndTime.Add(time.Second))
+	EndBlocker(ctx, keeper)
+	jailed, err = keeper.IsJailed(ctx, address)
+	assert.NoError(t, err)
+	assert.False(t, jailed)
+	account, _ = keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 0, account.SlashCount)
+
+	// the second episode lasts JailEscalationFactor times longer
+	_, err = keeper.IncrementSlashCount(ctx, address, 7, "Harassment")
+	assert.NoError(t, err)
+	account, _ = keeper.getAppAccount(ctx, address)
+	assert.Equal(t, 2, account.JailCount)
+	assert.True(t, ctx.BlockHeader().Time.Add(2*params.JailDuration).Equal(account.JailEndTime))
+
+	history := keeper.JailHistory(ctx, address)
+	assert.Len(t, history, 2)
+	assert.Equal(t, []SlashRecord{{SlashID: 1, Reason: "Spam"}}, history[0].Slashes)
+	assert.True(t, ctx.BlockHeader().Time.Equal(history[0].ReleasedTime))
+	assert.Equal(t, []SlashRecord{{SlashID: 7, Reason: "Harassment"}}, history[1].Slashes)
+	assert.True(t, history[1].ReleasedTime.IsZero())
+
+	_, broken := JailedAccountsInvariant(keeper)(ctx)
+	assert.False(t, broken)
+}
+
+func TestJailDuration_Capped(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	params := keeper.GetParams(ctx)
+
+	assert.Equal(t, params.JailDuration, keeper.jailDuration(ctx, 0))
+	assert.Equal(t, 4*params.JailDuration, keeper.jailDuration(ctx, 2))
+	assert.Equal(t, params.MaxJailDuration, keeper.jailDuration(ctx, 10))
+}
+
+func TestAddKey_LinkedAddressResolvesToIdentity(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, _, secondAddress := getFakeKeyPubAddr()
+
+	appAccount, err := keeper.AddKey(ctx, address, secondAddress)
+	assert.NoError(t, err)
+	assert.Len(t, appAccount.Addresses, 2)
+	assert.NotNil(t, keeper.accountKeeper.GetAccount(ctx, secondAddress))
+
+	identity, err := keeper.Identity(ctx, secondAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, address, identity)
+
+	// jailing through the linked address jails the identity
+	err = keeper.JailUntil(ctx, secondAddress, time.Now().AddDate(0, 0, 10))
+	assert.NoError(t, err)
+	jailed, err := keeper.IsJailed(ctx, address)
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+
+	_, err = keeper.AddKey(ctx, address, secondAddress)
+	assert.Equal(t, ErrAddressAlreadyLinked(secondAddress).Code(), err.Code())
+	_, _, thirdAddress := getFakeKeyPubAddr()
+	_, err = keeper.AddKey(ctx, secondAddress, thirdAddress)
+	assert.Equal(t, ErrNotPrimaryAddress(secondAddress).Code(), err.Code())
+}
+
+func TestPromoteKey_RemoveOldPrimary(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, _, secondAddress := getFakeKeyPubAddr()
+	_, err = keeper.AddKey(ctx, address, secondAddress)
+	assert.NoError(t, err)
+
+	_, err = keeper.RemoveKey(ctx, address, address)
+	assert.Equal(t, ErrCannotRemovePrimary(address).Code(), err.Code())
+
+	appAccount, err := keeper.PromoteKey(ctx, address, secondAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, secondAddress, appAccount.PrimaryAddress())
+	assert.Equal(t, address, appAccount.Identity)
+
+	// the registration address can be rotated out
+	appAccount, err = keeper.RemoveKey(ctx, secondAddress, address)
+	assert.NoError(t, err)
+	assert.Equal(t, []sdk.AccAddress{secondAddress}, appAccount.Addresses)
+
+	_, err = keeper.Identity(ctx, address)
+	assert.Equal(t, ErrAddressNotLinked(address).Code(), err.Code())
+	identity, err := keeper.Identity(ctx, secondAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, address, identity)
+	assert.Len(t, keeper.AppAccounts(ctx), 1)
+
+	_, err = keeper.PromoteKey(ctx, secondAddress, address)
+	assert.Equal(t, ErrAddressNotLinked(address).Code(), err.Code())
+}
+
+func TestSelfRegister_Puzzle(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address := getFakeKeyPubAddr()
+	nonce := SolveRegistrationProof(address, MinRegistrationDifficulty)
+	_, err := keeper.SelfRegister(ctx, address, publicKey, nonce, nil)
+	assert.Equal(t, ErrSelfRegistrationDisabled().Code(), err.Code())
+
+	params := keeper.GetParams(ctx)
+	params.SelfRegistration = true
+	params.RegistrationDifficulty = MinRegistrationDifficulty
+	params.RegistrationsPerBlock = 1
+	params.RegistrationsPerDay = 2
+	keeper.SetParams(ctx, params)
+
+	var badNonce uint64
+	for RegistrationProofBits(address, badNonce) >= MinRegistrationDifficulty {
+		badNonce++
+	}
+	_, err = keeper.SelfRegister(ctx, address, publicKey, badNonce, nil)
+	assert.Equal(t, ErrInvalidRegistrationProof("").Code(), err.Code())
+
+	appAccount, err := keeper.SelfRegister(ctx, address, publicKey, nonce, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, address, appAccount.PrimaryAddress())
+	acc, err := keeper.PrimaryAccount(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, acc.GetPubKey())
+
+	register := func(ctx sdk.Context) sdk.Error {
+		_, publicKey, address := getFakeKeyPubAddr()
+		_, err := keeper.SelfRegister(ctx, address, publicKey, SolveRegistrationProof(address, MinRegistrationDifficulty), nil)
+		return err
+	}
+	err = register(ctx)
+	assert.Equal(t, ErrRegistrationCapReached("block").Code(), err.Code())
+	assert.NoError(t, register(ctx.WithBlockHeight(1)))
+	// the day cap holds across blocks
+	err = register(ctx.WithBlockHeight(2))
+	assert.Equal(t, ErrRegistrationCapReached("day").Code(), err.Code())
+	assert.NoError(t, register(ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockHeader().Time.AddDate(0, 0, 1))))
+}
+
+func TestSelfRegister_Voucher(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	registrarKey, registrarPubKey, registrar := getFakeKeyPubAddr()
+	registrarAccount := keeper.accountKeeper.NewAccountWithAddress(ctx, registrar)
+	assert.NoError(t, registrarAccount.SetPubKey(registrarPubKey))
+	keeper.accountKeeper.SetAccount(ctx, registrarAccount)
+
+	params := keeper.GetParams(ctx)
+	params.Registrar = registrar
+	params.SelfRegistration = true
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, address := getFakeKeyPubAddr()
+	_, _, otherAddress := getFakeKeyPubAddr()
+	forged, err := registrarKey.Sign(VoucherSignBytes(ctx.ChainID(), otherAddress))
+	assert.NoError(t, err)
+	_, sdkErr := keeper.SelfRegister(ctx, address, publicKey, 0, forged)
+	assert.Equal(t, ErrInvalidRegistrationProof("").Code(), sdkErr.Code())
+
+	voucher, err := registrarKey.Sign(VoucherSignBytes(ctx.ChainID(), address))
+	assert.NoError(t, err)
+	_, sdkErr = keeper.SelfRegister(ctx, address, publicKey, 0, voucher)
+	assert.Nil(t, sdkErr)
+	_, err = keeper.PrimaryAccount(ctx, address)
+	assert.NoError(t, err)
+}
+
+func TestInviteCode_ReferralReward(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	handler := NewHandler(keeper)
+	params := keeper.GetParams(ctx)
+	params.MaxInviteCodes = 1
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, inviter, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, inviter, coins, publicKey)
+	assert.NoError(t, err)
+
+	_, err = keeper.CreateInviteCode(ctx, inviter)
+	assert.Equal(t, ErrNotEnoughEarnedToInvite(inviter).Code(), err.Code())
+
+	earning := sdk.NewCoin(params.ReferralReward.Denom, params.InviteMinEarned)
+	_, err = keeper.bankKeeper.AddCoin(ctx, inviter, earning, 1, bankexported.TransactionInterestUpvoteGiven)
+	assert.NoError(t, err)
+	invite, err := keeper.CreateInviteCode(ctx, inviter)
+	assert.NoError(t, err)
+	assert.Equal(t, inviter, invite.Inviter)
+	_, err = keeper.CreateInviteCode(ctx, inviter)
+	assert.Equal(t, ErrInviteLimitReached(1).Code(), err.Code())
+
+	_, publicKey, invitee, coins := getFakeAppAccountParams()
+	msg := NewMsgRegisterKey(params.Registrar, invitee, publicKey, "secp256k1", coins)
+	msg.InviteCode = invite.Code
+	assert.True(t, handler(ctx, msg).IsOK())
+
+	_, publicKey, other, coins := getFakeAppAccountParams()
+	msg = NewMsgRegisterKey(params.Registrar, other, publicKey, "secp256k1", coins)
+	msg.InviteCode = invite.Code
+	assert.Equal(t, ErrInviteCodeRedeemed(invite.Code).Code(), handler(ctx, msg).Code)
+
+	// no reward until the invitee earned the threshold
+	EndBlocker(ctx, keeper)
+	assert.True(t, keeper.ReferralEarnings(ctx, inviter).IsZero())
+
+	earning = sdk.NewCoin(params.ReferralReward.Denom, params.ReferralThreshold)
+	_, err = keeper.bankKeeper.AddCoin(ctx, invitee, earning, 2, bankexported.TransactionStakeWinnings)
+	assert.NoError(t, err)
+	EndBlocker(ctx, keeper)
+	EndBlocker(ctx, keeper)
+	assert.True(t, params.ReferralReward.IsEqual(keeper.ReferralEarnings(ctx, inviter)))
+
+	invite, ok := keeper.InviteCode(ctx, invite.Code)
+	assert.True(t, ok)
+	assert.Equal(t, invitee, invite.Invitee)
+	assert.True(t, invite.Rewarded)
+}
+
+func TestInviteCode_Collision(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	params := keeper.GetParams(ctx)
+
+	_, publicKey, inviter, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, inviter, coins, publicKey)
+	assert.NoError(t, err)
+	earning := sdk.NewCoin(params.ReferralReward.Denom, params.InviteMinEarned)
+	_, err = keeper.bankKeeper.AddCoin(ctx, inviter, earning, 1, bankexported.TransactionInterestUpvoteGiven)
+	assert.NoError(t, err)
+
+	// another user already holds the code the inviter would get
+	_, _, other, _ := getFakeAppAccountParams()
+	taken := InviteCode{Code: keeper.newInviteCode(ctx, inviter, 0), Inviter: other}
+	keeper.setInviteCode(ctx, taken)
+
+	invite, err := keeper.CreateInviteCode(ctx, inviter)
+	assert.NoError(t, err)
+	assert.NotEqual(t, taken.Code, invite.Code)
+	existing, ok := keeper.InviteCode(ctx, taken.Code)
+	assert.True(t, ok)
+	assert.Equal(t, other, existing.Inviter)
+}
+
+func TestInviteCode_ReferralCapAndExpiry(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	handler := NewHandler(keeper)
+	params := keeper.GetParams(ctx)
+	params.MaxInviteCodes = 3
+	params.MaxReferralChecksPerBlock = 1
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, inviter, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, inviter, coins, publicKey)
+	assert.NoError(t, err)
+	earning := sdk.NewCoin(params.ReferralReward.Denom, params.InviteMinEarned)
+	_, err = keeper.bankKeeper.AddCoin(ctx, inviter, earning, 1, bankexported.TransactionInterestUpvoteGiven)
+	assert.NoError(t, err)
+
+	invitees := make([]sdk.AccAddress, 0)
+	for i := 0; i < 3; i++ {
+		invite, err := keeper.CreateInviteCode(ctx, inviter)
+		assert.NoError(t, err)
+		_, publicKey, invitee, coins := getFakeAppAccountParams()
+		msg := NewMsgRegisterKey(params.Registrar, invitee, publicKey, "secp256k1", coins)
+		msg.InviteCode = invite.Code
+		assert.True(t, handler(ctx, msg).IsOK())
+		invitees = append(invitees, invitee)
+	}
+	earning = sdk.NewCoin(params.ReferralReward.Denom, params.ReferralThreshold)
+	for _, invitee := range invitees[:2] {
+		_, err = keeper.bankKeeper.AddCoin(ctx, invitee, earning, 2, bankexported.TransactionStakeWinnings)
+		assert.NoError(t, err)
+	}
+
+	// one referral is checked per block, the next block resumes after it
+	rewarded := sdk.ZeroInt()
+	for i := 0; i < 3; i++ {
+		EndBlocker(ctx, keeper)
+		paid := keeper.ReferralEarnings(ctx, inviter).Amount
+		assert.True(t, paid.Sub(rewarded).LTE(params.ReferralReward.Amount))
+		rewarded = paid
+	}
+	assert.True(t, params.ReferralReward.Amount.MulRaw(2).Equal(rewarded))
+
+	// the last invitee reaches the threshold after the referral expired
+	ctx = ctx.WithBlockTime(now.Add(params.ReferralExpiry + time.Second))
+	_, err = keeper.bankKeeper.AddCoin(ctx, invitees[2], earning, 3, bankexported.TransactionStakeWinnings)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		EndBlocker(ctx, keeper)
+	}
+	assert.True(t, params.ReferralReward.Amount.MulRaw(2).Equal(keeper.ReferralEarnings(ctx, inviter).Amount))
+	iterator := sdk.KVStorePrefixIterator(keeper.store(ctx), PendingReferralPrefix)
+	assert.False(t, iterator.Valid())
+	iterator.Close()
+}
+
+func TestDeactivateAccount_HiddenOnceClosed(t *testing.T) {
+	ctx, keeper := mockDB(t)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+
+	appAccount, err := keeper.DeactivateAccount(ctx, address)
+	assert.NoError(t, err)
+	assert.True(t, appAccount.IsDeactivated())
+	deactivated, err := keeper.IsDeactivated(ctx, address)
+	assert.NoError(t, err)
+	assert.True(t, deactivated)
+	_, err = keeper.DeactivateAccount(ctx, address)
+	assert.Equal(t, ErrAccountDeactivated(address).Code(), err.Code())
+	assert.Len(t, keeper.DeactivatedAccounts(ctx), 1)
+	assert.Len(t, keeper.DeactivatedAccountsAfter(ctx, nil, 1), 1)
+	assert.Len(t, keeper.DeactivatedAccountsAfter(ctx, address, 1), 0)
+
+	query := abci.RequestQuery{Data: ModuleCodec.MustMarshalJSON(QueryAppAccountParams{Address: address})}
+	_, err = queryAppAccount(ctx, query, keeper)
+	assert.NoError(t, err)
+
+	assert.NoError(t, keeper.CloseAccount(ctx, address))
+	assert.Len(t, keeper.DeactivatedAccounts(ctx), 0)
+	_, err = queryAppAccount(ctx, query, keeper)
+	assert.Equal(t, ErrAppAccountNotFound(address).Code(), err.Code())
+	// closed accounts are still kept for exports
+	appAccount, ok := keeper.AppAccount(ctx, address)
+	assert.True(t, ok)
+	assert.True(t, appAccount.Closed)
+}
+
+func TestAnteHandler_FeeExemptAndRateLimit(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	keeper.accountKeeper.SetParams(ctx, auth.DefaultParams())
+	params := keeper.GetParams(ctx)
+	params.MsgRateLimits = []MsgRateLimit{{MsgType: TypeMsgCreateInviteCode, Limit: 1}}
+	keeper.SetParams(ctx, params)
+	anteHandler := NewAnteHandler(keeper.accountKeeper, keeper.supplyKeeper, keeper, auth.DefaultSigVerificationGasConsumer)
+
+	privateKey, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	// the account holds no coins, so the fee can only pass when waived
+	fee := auth.NewStdFee(200000, sdk.NewCoins(app.NewShanevCoin(1)))
+	tx := signTx(t, ctx, keeper, privateKey, fee, NewMsgCreateInviteCode(address))
+
+	_, res, abort := anteHandler(ctx, tx, false)
+	assert.False(t, abort, res.Log)
+	assert.Equal(t, uint64(1), keeper.accountKeeper.GetAccount(ctx, address).GetSequence())
+
+	// replays fail the sequence check
+	_, res, abort = anteHandler(ctx, tx, false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeUnauthorized, res.Code)
+
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, NewMsgCreateInviteCode(address)), false)
+	assert.True(t, abort)
+	assert.Equal(t, ErrorCodeRateLimitExceeded, res.Code)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.RateLimitPeriod))
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, NewMsgCreateInviteCode(address)), false)
+	assert.False(t, abort, res.Log)
+
+	params.FeeExemptMsgTypes = []string{}
+	keeper.SetParams(ctx, params)
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, NewMsgDeactivateAccount(address)), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+}
+
+func TestAnteHandler_SelfRegistration(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockHeight(1)
+	keeper.accountKeeper.SetParams(ctx, auth.DefaultParams())
+	anteHandler := NewAnteHandler(keeper.accountKeeper, keeper.supplyKeeper, keeper, auth.DefaultSigVerificationGasConsumer)
+
+	privateKey, publicKey, address := getFakeKeyPubAddr()
+	nonce := SolveRegistrationProof(address, MinRegistrationDifficulty)
+	msg := NewMsgSelfRegister(address, publicKey, "secp256k1", nonce, nil)
+	// the new key holds no coins, so the fee can only pass when waived
+	fee := auth.NewStdFee(200000, sdk.NewCoins(app.NewShanevCoin(1)))
+
+	// disabled self registration pays the normal fee
+	_, res, abort := anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, msg), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+
+	params := keeper.GetParams(ctx)
+	params.SelfRegistration = true
+	params.RegistrationDifficulty = MinRegistrationDifficulty
+	params.RegistrationsPerBlock = 1
+	keeper.SetParams(ctx, params)
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, msg), false)
+	assert.False(t, abort, res.Log)
+	// the auth account is left to the registration
+	assert.Nil(t, keeper.accountKeeper.GetAccount(ctx, address))
+
+	// a puzzle under the current difficulty pays the normal fee
+	params.RegistrationDifficulty = MinRegistrationDifficulty + 8
+	keeper.SetParams(ctx, params)
+	if RegistrationProofBits(address, nonce) < params.RegistrationDifficulty {
+		_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, msg), false)
+		assert.True(t, abort)
+		assert.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+	}
+	params.RegistrationDifficulty = MinRegistrationDifficulty
+	keeper.SetParams(ctx, params)
+
+	// so does a registration over the block cap
+	_, err := keeper.SelfRegister(ctx, address, publicKey, nonce, nil)
+	assert.NoError(t, err)
+	otherPrivateKey, otherPublicKey, otherAddress := getFakeKeyPubAddr()
+	otherMsg := NewMsgSelfRegister(otherAddress, otherPublicKey, "secp256k1", SolveRegistrationProof(otherAddress, MinRegistrationDifficulty), nil)
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, otherPrivateKey, fee, otherMsg), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+
+	// handlers don't run in CheckTx, the ante handler counts the registrations it lets in for free
+	checkCtx := ctx.WithBlockHeight(2).WithIsCheckTx(true)
+	_, res, abort = anteHandler(checkCtx, signTx(t, checkCtx, keeper, otherPrivateKey, fee, otherMsg), false)
+	assert.False(t, abort, res.Log)
+	thirdPrivateKey, thirdPublicKey, thirdAddress := getFakeKeyPubAddr()
+	thirdMsg := NewMsgSelfRegister(thirdAddress, thirdPublicKey, "secp256k1", SolveRegistrationProof(thirdAddress, MinRegistrationDifficulty), nil)
+	_, res, abort = anteHandler(checkCtx, signTx(t, checkCtx, keeper, thirdPrivateKey, fee, thirdMsg), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+
+	_, res, abort = anteHandler(ctx, signTx(t, ctx, keeper, privateKey, auth.NewStdFee(200000, nil), NewMsgCreateInviteCode(address)), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeUnknownAddress, res.Code)
+}
+
+func TestAnteHandler_AddKeyConsent(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	keeper.accountKeeper.SetParams(ctx, auth.DefaultParams())
+	anteHandler := NewAnteHandler(keeper.accountKeeper, keeper.supplyKeeper, keeper, auth.DefaultSigVerificationGasConsumer)
+
+	privateKey, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	newPrivateKey, _, newAddress := getFakeKeyPubAddr()
+	fee := auth.NewStdFee(200000, nil)
+	msg := NewMsgAddKey(address, newAddress)
+
+	// linking a key without its signature is rejected
+	_, res, abort := anteHandler(ctx, signTx(t, ctx, keeper, privateKey, fee, msg), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeUnauthorized, res.Code)
+
+	// a signature from another key doesn't count as consent
+	otherPrivateKey, _, _ := getFakeKeyPubAddr()
+	_, res, abort = anteHandler(ctx, signTxBy(t, ctx, keeper, []crypto.PrivKey{privateKey, otherPrivateKey}, fee, msg), false)
+	assert.True(t, abort)
+	assert.Equal(t, sdk.CodeInvalidPubKey, res.Code)
+
+	// the new key has no auth account yet and co-signs with its public key
+	_, res, abort = anteHandler(ctx, signTxBy(t, ctx, keeper, []crypto.PrivKey{privateKey, newPrivateKey}, fee, msg), false)
+	assert.False(t, abort, res.Log)
+	assert.Nil(t, keeper.accountKeeper.GetAccount(ctx, newAddress))
+}
@@ -12,10 +12,45 @@ import (
 // - 0x00<AccAddress>: AppAccount
 //
 // - 0x10<jailEndTime_Bytes><AccAddress>: AccAddress
+//
+// - 0x20<AccAddress><slashID_Bytes>: SlashRecord
+//
+// - 0x30<AccAddress><number_Bytes>: JailEpisode
+//
+// - 0x40<AccAddress>: identity AccAddress of the linked AppAccount
+//
+// - 0x50: registrationCounter
+//
+// - 0x60<code>: InviteCode
+//
+// - 0x61<AccAddress><code>: code of an invite minted by the address
+//
+// - 0x62<code>: code of a redeemed invite waiting for its referral reward
+//
+// - 0x63: code of the last pending referral checked when a block hit the cap
+//
+// - 0x70<AccAddress>: identity of a deactivated AppAccount waiting to be closed
+//
+// - 0x80<AccAddress><msgType>: rateLimitCounter
 var (
 	AppAccountKeyPrefix = []byte{0x00}
 
 	JailEndTimeAccountPrefix = []byte{0x10}
+	PendingSlashPrefix       = []byte{0x20}
+	JailEpisodePrefix        = []byte{0x30}
+	LinkedAddressPrefix      = []byte{0x40}
+
+	RegistrationCounterKey = []byte{0x50}
+
+	InviteCodePrefix      = []byte{0x60}
+	UserInviteCodePrefix  = []byte{0x61}
+	PendingReferralPrefix = []byte{0x62}
+	// PendingReferralCursorKey holds the last pending referral checked when a block hit the cap
+	PendingReferralCursorKey = []byte{0x63}
+
+	DeactivatedAccountPrefix = []byte{0x70}
+
+	RateLimitPrefix = []byte{0x80}
 )
 
 func key(addr sdk.AccAddress) []byte {
@@ -29,3 +64,47 @@ func jailEndTimeAccountsKey(endTime time.Time) []byte {
 func jailEndTimeAccountKey(endTime time.Time, addr sdk.AccAddress) []byte {
 	return append(jailEndTimeAccountsKey(endTime), addr.Bytes()...)
 }
+
+func pendingSlashesKey(addr sdk.AccAddress) []byte {
+	return append(PendingSlashPrefix, addr.Bytes()...)
+}
+
+func pendingSlashKey(addr sdk.AccAddress, slashID uint64) []byte {
+	return append(pendingSlashesKey(addr), sdk.Uint64ToBigEndian(slashID)...)
+}
+
+func jailEpisodesKey(addr sdk.AccAddress) []byte {
+	return append(JailEpisodePrefix, addr.Bytes()...)
+}
+
+func jailEpisodeKey(addr sdk.AccAddress, number int) []byte {
+	return append(jailEpisodesKey(addr), sdk.Uint64ToBigEndian(uint64(number))...)
+}
+
+func linkedAddressKey(addr sdk.AccAddress) []byte {
+	return append(LinkedAddressPrefix, addr.Bytes()...)
+}
+
+func inviteCodeKey(code string) []byte {
+	return append(InviteCodePrefix, []byte(code)...)
+}
+
+func userInviteCodesKey(addr sdk.AccAddress) []byte {
+	return append(UserInviteCodePrefix, addr.Bytes()...)
+}
+
+func userInviteCodeKey(addr sdk.AccAddress, code string) []byte {
+	return append(userInviteCodesKey(addr), []byte(code)...)
+}
+
+func pendingReferralKey(code string) []byte {
+	return append(PendingReferralPrefix, []byte(code)...)
+}
+
+func deactivatedAccountKey(addr sdk.AccAddress) []byte {
+	return append(DeactivatedAccountPrefix, addr.Bytes()...)
+}
+
+func rateLimitKey(addr sdk.AccAddress, msgType string) []byte {
+	return append(append(RateLimitPrefix, addr.Bytes()...), []byte(msgType)...)
+}
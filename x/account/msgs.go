@@ -12,6 +12,18 @@ const (
 	TypeMsgRegisterKey = "register_key"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgAddKey represents the type of the message for linking a key to an account
+	TypeMsgAddKey = "add_key"
+	// TypeMsgRemoveKey represents the type of the message for unlinking a key from an account
+	TypeMsgRemoveKey = "remove_key"
+	// TypeMsgPromoteKey represents the type of the message for making a key the primary one
+	TypeMsgPromoteKey = "promote_key"
+	// TypeMsgSelfRegister represents the type of the message for registering a key without the registrar
+	TypeMsgSelfRegister = "self_register"
+	// TypeMsgCreateInviteCode represents the type of the message for minting an invite code
+	TypeMsgCreateInviteCode = "create_invite_code"
+	// TypeMsgDeactivateAccount represents the type of the message for closing an account
+	TypeMsgDeactivateAccount = "deactivate_account"
 )
 
 // MsgRegisterKey defines the message to register a new key
@@ -21,6 +33,8 @@ type MsgRegisterKey struct {
 	PubKey     crypto.PubKey  `json:"public_key"`
 	PubKeyAlgo string         `json:"public_key_algo"`
 	Coins      sdk.Coins      `json:"coins"`
+	// InviteCode optionally credits an existing user with the registration
+	InviteCode string `json:"invite_code,omitempty"`
 }
 
 // NewMsgRegisterKey returns the messages to register a new key
@@ -101,3 +115,269 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgAddKey defines the message that links a new address to the account of the primary address
+type MsgAddKey struct {
+	Creator sdk.AccAddress `json:"creator"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewMsgAddKey returns the message to link an address
+func NewMsgAddKey(creator, address sdk.AccAddress) MsgAddKey {
+	return MsgAddKey{
+		Creator: creator,
+		Address: address,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAddKey) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid creator: %s", msg.Creator.String()))
+	}
+
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAddKey) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAddKey) Type() string { return TypeMsgAddKey }
+
+// GetSignBytes implements Msg
+func (msg MsgAddKey) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. The new address co-signs to consent to the link.
+func (msg MsgAddKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator, msg.Address}
+}
+
+// MsgRemoveKey defines the message that unlinks an address from the account of the primary address
+type MsgRemoveKey struct {
+	Creator sdk.AccAddress `json:"creator"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewMsgRemoveKey returns the message to unlink an address
+func NewMsgRemoveKey(creator, address sdk.AccAddress) MsgRemoveKey {
+	return MsgRemoveKey{
+		Creator: creator,
+		Address: address,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveKey) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid creator: %s", msg.Creator.String()))
+	}
+
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgRemoveKey) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveKey) Type() string { return TypeMsgRemoveKey }
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveKey) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the primary address as the signer.
+func (msg MsgRemoveKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgPromoteKey defines the message that makes a linked address the primary address of the account
+type MsgPromoteKey struct {
+	Creator sdk.AccAddress `json:"creator"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewMsgPromoteKey returns the message to promote an address
+func NewMsgPromoteKey(creator, address sdk.AccAddress) MsgPromoteKey {
+	return MsgPromoteKey{
+		Creator: creator,
+		Address: address,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgPromoteKey) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid creator: %s", msg.Creator.String()))
+	}
+
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgPromoteKey) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgPromoteKey) Type() string { return TypeMsgPromoteKey }
+
+// GetSignBytes implements Msg
+func (msg MsgPromoteKey) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the primary address as the signer.
+func (msg MsgPromoteKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgSelfRegister defines the message for a new key to register itself.
+// It carries either a voucher signed by the registrar or a puzzle nonce.
+type MsgSelfRegister struct {
+	Address    sdk.AccAddress `json:"address"`
+	PubKey     crypto.PubKey  `json:"public_key"`
+	PubKeyAlgo string         `json:"public_key_algo"`
+	Nonce      uint64         `json:"nonce"`
+	Voucher    []byte         `json:"voucher"`
+	InviteCode string         `json:"invite_code,omitempty"`
+}
+
+// NewMsgSelfRegister returns the message for a key to register itself
+func NewMsgSelfRegister(address sdk.AccAddress, publicKey crypto.PubKey, publicKeyAlgo string,
+	nonce uint64, voucher []byte) MsgSelfRegister {
+	return MsgSelfRegister{
+		Address:    address,
+		PubKey:     publicKey,
+		PubKeyAlgo: publicKeyAlgo,
+		Nonce:      nonce,
+		Voucher:    voucher,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSelfRegister) ValidateBasic() sdk.Error {
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Address.String()))
+	}
+
+	if msg.PubKey == nil || !msg.Address.Equals(sdk.AccAddress(msg.PubKey.Address())) {
+		return sdk.ErrInvalidPubKey("Public key does not match the address")
+	}
+
+	// puzzles are checked here so cheap spam never reaches the keeper
+	if len(msg.Voucher) == 0 && RegistrationProofBits(msg.Address, msg.Nonce) < MinRegistrationDifficulty {
+		return ErrInvalidRegistrationProof(fmt.Sprintf("puzzle solution under %d bits", MinRegistrationDifficulty))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSelfRegister) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSelfRegister) Type() string { return TypeMsgSelfRegister }
+
+// GetSignBytes implements Msg
+func (msg MsgSelfRegister) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the registering key as the signer.
+func (msg MsgSelfRegister) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// MsgCreateInviteCode defines the message to mint an invite code
+type MsgCreateInviteCode struct {
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgCreateInviteCode returns the message to mint an invite code
+func NewMsgCreateInviteCode(creator sdk.AccAddress) MsgCreateInviteCode {
+	return MsgCreateInviteCode{
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCreateInviteCode) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid creator: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCreateInviteCode) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateInviteCode) Type() string { return TypeMsgCreateInviteCode }
+
+// GetSignBytes implements Msg
+func (msg MsgCreateInviteCode) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgCreateInviteCode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// MsgDeactivateAccount defines the message to close the account of the primary address
+type MsgDeactivateAccount struct {
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgDeactivateAccount returns the message to close an account
+func NewMsgDeactivateAccount(creator sdk.AccAddress) MsgDeactivateAccount {
+	return MsgDeactivateAccount{
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDeactivateAccount) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid creator: %s", msg.Creator.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgDeactivateAccount) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDeactivateAccount) Type() string { return TypeMsgDeactivateAccount }
+
+// GetSignBytes implements Msg
+func (msg MsgDeactivateAccount) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the primary address as the signer.
+func (msg MsgDeactivateAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
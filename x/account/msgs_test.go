@@ -34,3 +34,45 @@ func TestMsgNewCommunity_InvalidAddress(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgAddKey_InvalidAddress(t *testing.T) {
+	_, _, address := getFakeKeyPubAddr()
+
+	msg := NewMsgAddKey(address, sdk.AccAddress(nil))
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
+	assert.Equal(t, TypeMsgAddKey, msg.Type())
+}
+
+func TestMsgAddKey_Signers(t *testing.T) {
+	_, _, address := getFakeKeyPubAddr()
+	_, _, newAddress := getFakeKeyPubAddr()
+
+	msg := NewMsgAddKey(address, newAddress)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, []sdk.AccAddress{address, newAddress}, msg.GetSigners())
+}
+
+func TestMsgSelfRegister_InvalidProof(t *testing.T) {
+	_, publicKey, address := getFakeKeyPubAddr()
+	_, otherPublicKey, _ := getFakeKeyPubAddr()
+	nonce := SolveRegistrationProof(address, MinRegistrationDifficulty)
+
+	msg := NewMsgSelfRegister(address, publicKey, "secp256k1", nonce, nil)
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, TypeMsgSelfRegister, msg.Type())
+
+	msg = NewMsgSelfRegister(address, otherPublicKey, "secp256k1", nonce, nil)
+	assert.Equal(t, sdk.ErrInvalidPubKey("").Code(), msg.ValidateBasic().Code())
+
+	var badNonce uint64
+	for RegistrationProofBits(address, badNonce) >= MinRegistrationDifficulty {
+		badNonce++
+	}
+	msg = NewMsgSelfRegister(address, publicKey, "secp256k1", badNonce, nil)
+	assert.Equal(t, ErrInvalidRegistrationProof("").Code(), msg.ValidateBasic().Code())
+	// a voucher is checked by the keeper against the registrar
+	msg = NewMsgSelfRegister(address, publicKey, "secp256k1", badNonce, []byte("voucher"))
+	assert.Nil(t, msg.ValidateBasic())
+}
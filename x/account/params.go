@@ -5,17 +5,35 @@ import (
 	"reflect"
 	"time"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Keys for params
 var (
-	KeyRegistrar             = []byte("registrar")
-	KeyMaxSlashCount         = []byte("maxSlashCount")
-	KeyJailDuration          = []byte("jailTime")
-	KeyUserGrowthAllocation  = []byte("userGrowthAllocation")
-	KeyStakeholderAllocation = []byte("stakeholderAllocation")
+	KeyRegistrar              = []byte("registrar")
+	KeyMaxSlashCount          = []byte("maxSlashCount")
+	KeyJailDuration           = []byte("jailTime")
+	KeyUserGrowthAllocation   = []byte("userGrowthAllocation")
+	KeyStakeholderAllocation  = []byte("stakeholderAllocation")
+	KeySlashDecayPeriod       = []byte("slashDecayPeriod")
+	KeyJailEscalationFactor   = []byte("jailEscalationFactor")
+	KeyMaxJailDuration        = []byte("maxJailDuration")
+	KeySelfRegistration       = []byte("selfRegistration")
+	KeyRegistrationDifficulty = []byte("registrationDifficulty")
+	KeySelfRegistrationGrant  = []byte("selfRegistrationGrant")
+	KeyRegistrationsPerBlock  = []byte("registrationsPerBlock")
+	KeyRegistrationsPerDay    = []byte("registrationsPerDay")
+	KeyInviteMinEarned        = []byte("inviteMinEarned")
+	KeyMaxInviteCodes         = []byte("maxInviteCodes")
+	KeyReferralThreshold      = []byte("referralThreshold")
+	KeyReferralReward         = []byte("referralReward")
+	KeyReferralExpiry         = []byte("referralExpiry")
+	KeyMaxReferralChecks      = []byte("maxReferralChecksPerBlock")
+	KeyFeeExemptMsgTypes      = []byte("feeExemptMsgTypes")
+	KeyRateLimitPeriod        = []byte("rateLimitPeriod")
+	KeyMsgRateLimits          = []byte("msgRateLimits")
 )
 
 // Params holds parameters for Auth
@@ -25,16 +43,64 @@ type Params struct {
 	JailDuration          time.Duration  `json:"jail_duration"`
 	UserGrowthAllocation  sdk.Dec        `json:"user_growth_allocation"`
 	StakeholderAllocation sdk.Dec        `json:"stakeholder_allocation"`
+	SlashDecayPeriod      time.Duration  `json:"slash_decay_period"`
+	JailEscalationFactor  int            `json:"jail_escalation_factor"`
+	MaxJailDuration       time.Duration  `json:"max_jail_duration"`
+	// SelfRegistration lets new keys register without the registrar
+	SelfRegistration       bool     `json:"self_registration"`
+	RegistrationDifficulty int      `json:"registration_difficulty"`
+	SelfRegistrationGrant  sdk.Coin `json:"self_registration_grant"`
+	RegistrationsPerBlock  int      `json:"registrations_per_block"`
+	RegistrationsPerDay    int      `json:"registrations_per_day"`
+	// InviteMinEarned is what a user has to have earned to mint invite codes
+	InviteMinEarned   sdk.Int  `json:"invite_min_earned"`
+	MaxInviteCodes    int      `json:"max_invite_codes"`
+	ReferralThreshold sdk.Int  `json:"referral_threshold"`
+	ReferralReward    sdk.Coin `json:"referral_reward"`
+	// ReferralExpiry drops referrals whose invitee didn't reach ReferralThreshold in time
+	ReferralExpiry            time.Duration `json:"referral_expiry"`
+	MaxReferralChecksPerBlock int           `json:"max_referral_checks_per_block"`
+	// FeeExemptMsgTypes are the message types registered users send without fees
+	FeeExemptMsgTypes []string       `json:"fee_exempt_msg_types"`
+	RateLimitPeriod   time.Duration  `json:"rate_limit_period"`
+	MsgRateLimits     []MsgRateLimit `json:"msg_rate_limits"`
 }
 
 // DefaultParams is the auth params for testing
 func DefaultParams() Params {
 	return Params{
-		Registrar:             nil,
-		MaxSlashCount:         3,
-		JailDuration:          24 * time.Hour * 7,
-		UserGrowthAllocation:  sdk.NewDecWithPrec(20, 2),
-		StakeholderAllocation: sdk.NewDecWithPrec(20, 2),
+		Registrar:                 nil,
+		MaxSlashCount:             3,
+		JailDuration:              24 * time.Hour * 7,
+		UserGrowthAllocation:      sdk.NewDecWithPrec(20, 2),
+		StakeholderAllocation:     sdk.NewDecWithPrec(20, 2),
+		SlashDecayPeriod:          24 * time.Hour * 30,
+		JailEscalationFactor:      2,
+		MaxJailDuration:           24 * time.Hour * 90,
+		SelfRegistration:          false,
+		RegistrationDifficulty:    20,
+		SelfRegistrationGrant:     app.InitialStake,
+		RegistrationsPerBlock:     10,
+		RegistrationsPerDay:       1000,
+		InviteMinEarned:           sdk.NewInt(10 * app.Shanev),
+		MaxInviteCodes:            5,
+		ReferralThreshold:         sdk.NewInt(10 * app.Shanev),
+		ReferralReward:            app.NewShanevCoin(50),
+		ReferralExpiry:            24 * time.Hour * 90,
+		MaxReferralChecksPerBlock: 50,
+		FeeExemptMsgTypes: []string{
+			"create_claim", "submit_argument", "submit_upvote", "edit_argument",
+			"delete_argument", "withdraw_stake", "slash_argument", "appeal_slash",
+			TypeMsgAddKey, TypeMsgRemoveKey, TypeMsgPromoteKey,
+			TypeMsgCreateInviteCode, TypeMsgDeactivateAccount,
+		},
+		RateLimitPeriod: time.Hour,
+		MsgRateLimits: []MsgRateLimit{
+			{MsgType: "create_claim", Limit: 10},
+			{MsgType: "submit_argument", Limit: 20},
+			{MsgType: "submit_upvote", Limit: 50},
+			{MsgType: "slash_argument", Limit: 20},
+		},
 	}
 }
 
@@ -46,6 +112,23 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyJailDuration, Value: &p.JailDuration},
 		{Key: KeyUserGrowthAllocation, Value: &p.UserGrowthAllocation},
 		{Key: KeyStakeholderAllocation, Value: &p.StakeholderAllocation},
+		{Key: KeySlashDecayPeriod, Value: &p.SlashDecayPeriod},
+		{Key: KeyJailEscalationFactor, Value: &p.JailEscalationFactor},
+		{Key: KeyMaxJailDuration, Value: &p.MaxJailDuration},
+		{Key: KeySelfRegistration, Value: &p.SelfRegistration},
+		{Key: KeyRegistrationDifficulty, Value: &p.RegistrationDifficulty},
+		{Key: KeySelfRegistrationGrant, Value: &p.SelfRegistrationGrant},
+		{Key: KeyRegistrationsPerBlock, Value: &p.RegistrationsPerBlock},
+		{Key: KeyRegistrationsPerDay, Value: &p.RegistrationsPerDay},
+		{Key: KeyInviteMinEarned, Value: &p.InviteMinEarned},
+		{Key: KeyMaxInviteCodes, Value: &p.MaxInviteCodes},
+		{Key: KeyReferralThreshold, Value: &p.ReferralThreshold},
+		{Key: KeyReferralReward, Value: &p.ReferralReward},
+		{Key: KeyReferralExpiry, Value: &p.ReferralExpiry},
+		{Key: KeyMaxReferralChecks, Value: &p.MaxReferralChecksPerBlock},
+		{Key: KeyFeeExemptMsgTypes, Value: &p.FeeExemptMsgTypes},
+		{Key: KeyRateLimitPeriod, Value: &p.RateLimitPeriod},
+		{Key: KeyMsgRateLimits, Value: &p.MsgRateLimits},
 	}
 }
 
@@ -10,11 +10,14 @@ import (
 
 // query endpoints supported by the ahchain Querier
 const (
-	QueryAppAccount      = "account"
-	QueryAppAccounts     = "accounts"
-	QueryPrimaryAccount  = "primary_account"
-	QueryPrimaryAccounts = "primary_accounts"
-	QueryParams          = "params"
+	QueryAppAccount       = "account"
+	QueryAppAccounts      = "accounts"
+	QueryPrimaryAccount   = "primary_account"
+	QueryPrimaryAccounts  = "primary_accounts"
+	QueryParams           = "params"
+	QueryJailHistory      = "jail_history"
+	QueryInviteCodes      = "invite_codes"
+	QueryReferralEarnings = "referral_earnings"
 )
 
 // QueryAppAccountParams are params for querying app accounts by address queries
@@ -37,6 +40,21 @@ type QueryPrimaryAccountsParams struct {
 	Addresses []sdk.AccAddress `json:"addresses"`
 }
 
+// QueryJailHistoryParams are params for querying the jail episodes of an account
+type QueryJailHistoryParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryInviteCodesParams are params for querying the invite codes minted by a user
+type QueryInviteCodesParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryReferralEarningsParams are params for querying the referral rewards paid to a user
+type QueryReferralEarningsParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, request abci.RequestQuery) ([]byte, sdk.Error) {
@@ -51,6 +69,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryPrimaryAccounts(ctx, request, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryJailHistory:
+			return queryJailHistory(ctx, request, keeper)
+		case QueryInviteCodes:
+			return queryInviteCodes(ctx, request, keeper)
+		case QueryReferralEarnings:
+			return queryReferralEarnings(ctx, request, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Unknown ahchain query endpoint: auth/%s", path[0]))
 		}
@@ -63,8 +87,9 @@ func queryAppAccount(ctx sdk.Context, request abci.RequestQuery, k Keeper) (resu
 		return
 	}
 
+	// closed accounts are hidden
 	appAccount, ok := k.getAppAccount(ctx, params.Address)
-	if !ok {
+	if !ok || appAccount.Closed {
 		return nil, ErrAppAccountNotFound(params.Address)
 	}
 
@@ -105,7 +130,7 @@ func queryAppAccounts(ctx sdk.Context, request abci.RequestQuery, k Keeper) (res
 
 	for _, addr := range params.Addresses {
 		appAccount, ok := k.getAppAccount(ctx, addr)
-		if !ok {
+		if !ok || appAccount.Closed {
 			return nil, ErrAppAccountNotFound(addr)
 		}
 		accounts = append(accounts, appAccount)
@@ -138,6 +163,59 @@ func queryPrimaryAccounts(ctx sdk.Context, request abci.RequestQuery, k Keeper)
 	return result, nil
 }
 
+func queryJailHistory(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryJailHistoryParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+	if _, ok := k.getAppAccount(ctx, params.Address); !ok {
+		return nil, ErrAppAccountNotFound(params.Address)
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, k.JailHistory(ctx, params.Address))
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
+func queryInviteCodes(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryInviteCodesParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+	inviter, err := k.Identity(ctx, params.Address)
+	if err != nil {
+		return
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, k.UserInviteCodes(ctx, inviter))
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
+func queryReferralEarnings(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryReferralEarningsParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+	inviter, err := k.Identity(ctx, params.Address)
+	if err != nil {
+		return
+	}
+
+	result, jsonErr := codec.MarshalJSONIndent(k.codec, k.ReferralEarnings(ctx, inviter))
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+
+	return result, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
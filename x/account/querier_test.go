@@ -120,6 +120,40 @@ func TestQueryAppAccount_ErrNotFound(t *testing.T) {
 	assert.Equal(t, ErrAppAccountNotFound(address).Code(), sdkErr.Code())
 }
 
+func TestQueryJailHistory_Success(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	params := keeper.GetParams(ctx)
+	params.MaxSlashCount = 1
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	_, err = keeper.IncrementSlashCount(ctx, address, 4, "Plagiarism")
+	assert.NoError(t, err)
+
+	queryParams, jsonErr := ModuleCodec.MarshalJSON(QueryJailHistoryParams{
+		Address: address,
+	})
+	assert.NoError(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/%s/%s", ModuleName, QueryJailHistory),
+		Data: queryParams,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryJailHistory}, query)
+	require.NoError(t, err)
+
+	var history []JailEpisode
+	jsonErr = ModuleCodec.UnmarshalJSON(resBytes, &history)
+	assert.NoError(t, jsonErr)
+	assert.Len(t, history, 1)
+	assert.Equal(t, 1, history[0].Number)
+	assert.Equal(t, []SlashRecord{{SlashID: 4, Reason: "Plagiarism"}}, history[0].Slashes)
+}
+
 func TestQueryParams_Success(t *testing.T) {
 	ctx, keeper := mockDB(t)
 
@@ -138,3 +172,38 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryInviteCodes_Success(t *testing.T) {
+	ctx, keeper := mockDB(t)
+	params := keeper.GetParams(ctx)
+	params.InviteMinEarned = sdk.ZeroInt()
+	keeper.SetParams(ctx, params)
+
+	_, publicKey, address, coins := getFakeAppAccountParams()
+	_, err := keeper.CreateAppAccount(ctx, address, coins, publicKey)
+	assert.NoError(t, err)
+	first, err := keeper.CreateInviteCode(ctx, address)
+	assert.NoError(t, err)
+	second, err := keeper.CreateInviteCode(ctx, address)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Code, second.Code)
+
+	queryParams, jsonErr := ModuleCodec.MarshalJSON(QueryInviteCodesParams{
+		Address: address,
+	})
+	assert.NoError(t, jsonErr)
+
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/%s/%s", ModuleName, QueryInviteCodes),
+		Data: queryParams,
+	}
+
+	querier := NewQuerier(keeper)
+	resBytes, err := querier(ctx, []string{QueryInviteCodes}, query)
+	require.NoError(t, err)
+
+	var invites []InviteCode
+	jsonErr = ModuleCodec.UnmarshalJSON(resBytes, &invites)
+	assert.NoError(t, jsonErr)
+	assert.Len(t, invites, 2)
+}
@@ -0,0 +1,146 @@
+package account
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// MinRegistrationDifficulty is the puzzle difficulty, in leading zero bits,
+// every self registration without a voucher has to meet in ValidateBasic
+const MinRegistrationDifficulty = 8
+
+const secondsPerDay = 24 * 60 * 60
+
+// registrationCounter counts the self registrations of the current block and day
+type registrationCounter struct {
+	Height     int64 `json:"height"`
+	BlockCount int   `json:"block_count"`
+	Day        int64 `json:"day"`
+	DayCount   int   `json:"day_count"`
+}
+
+// registrationVoucher is what the registrar signs to let an address register itself
+type registrationVoucher struct {
+	ChainID string         `json:"chain_id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// VoucherSignBytes returns the bytes the registrar signs to vouch for an address
+func VoucherSignBytes(chainID string, address sdk.AccAddress) []byte {
+	bz := ModuleCodec.MustMarshalJSON(registrationVoucher{ChainID: chainID, Address: address})
+	return sdk.MustSortJSON(bz)
+}
+
+// RegistrationProofBits returns the number of leading zero bits of the
+// sha256 hash of an address followed by a big endian nonce
+func RegistrationProofBits(address sdk.AccAddress, nonce uint64) int {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+	hash := sha256.Sum256(append(address.Bytes(), nonceBytes...))
+
+	zeros := 0
+	for _, b := range hash {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	return zeros
+}
+
+// SolveRegistrationProof finds the first nonce meeting a difficulty for an address
+func SolveRegistrationProof(address sdk.AccAddress, difficulty int) uint64 {
+	var nonce uint64
+	for RegistrationProofBits(address, nonce) < difficulty {
+		nonce++
+	}
+	return nonce
+}
+
+// SelfRegister creates an AppAccount for a new key without the registrar.
+// The key proves itself with a registrar signed voucher or a puzzle solution,
+// and is granted SelfRegistrationGrant within the per block and per day caps.
+func (k Keeper) SelfRegister(ctx sdk.Context, address sdk.AccAddress, pubKey crypto.PubKey,
+	nonce uint64, voucher []byte) (AppAccount, sdk.Error) {
+	params := k.GetParams(ctx)
+	if !params.SelfRegistration {
+		return AppAccount{}, ErrSelfRegistrationDisabled()
+	}
+	err := k.verifyRegistrationProof(ctx, address, nonce, voucher)
+	if err != nil {
+		return AppAccount{}, err
+	}
+	counter, err := k.countRegistration(ctx, k.registrationCounter(ctx))
+	if err != nil {
+		return AppAccount{}, err
+	}
+
+	appAccount, err := k.CreateAppAccount(ctx, address, sdk.NewCoins(params.SelfRegistrationGrant), pubKey)
+	if err != nil {
+		return appAccount, err
+	}
+	k.setRegistrationCounter(ctx, counter)
+
+	return appAccount, nil
+}
+
+func (k Keeper) verifyRegistrationProof(ctx sdk.Context, address sdk.AccAddress, nonce uint64, voucher []byte) sdk.Error {
+	params := k.GetParams(ctx)
+	if len(voucher) == 0 {
+		if RegistrationProofBits(address, nonce) < params.RegistrationDifficulty {
+			return ErrInvalidRegistrationProof(fmt.Sprintf("puzzle solution under %d bits", params.RegistrationDifficulty))
+		}
+		return nil
+	}
+
+	registrar := k.accountKeeper.GetAccount(ctx, params.Registrar)
+	if registrar == nil || registrar.GetPubKey() == nil {
+		return ErrInvalidRegistrationProof("registrar has no public key")
+	}
+	if !registrar.GetPubKey().VerifyBytes(VoucherSignBytes(ctx.ChainID(), address), voucher) {
+		return ErrInvalidRegistrationProof("voucher not signed by the registrar")
+	}
+	return nil
+}
+
+// countRegistration returns the counter with the new registration added,
+// or an error when a cap is reached
+func (k Keeper) countRegistration(ctx sdk.Context, counter registrationCounter) (registrationCounter, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	height, day := ctx.BlockHeight(), ctx.BlockHeader().Time.Unix()/secondsPerDay
+	if counter.Height != height {
+		counter.Height, counter.BlockCount = height, 0
+	}
+	if counter.Day != day {
+		counter.Day, counter.DayCount = day, 0
+	}
+	if counter.BlockCount >= params.RegistrationsPerBlock {
+		return counter, ErrRegistrationCapReached("block")
+	}
+	if counter.DayCount >= params.RegistrationsPerDay {
+		return counter, ErrRegistrationCapReached("day")
+	}
+	counter.BlockCount++
+	counter.DayCount++
+
+	return counter, nil
+}
+
+func (k Keeper) registrationCounter(ctx sdk.Context) (counter registrationCounter) {
+	bz := k.store(ctx).Get(RegistrationCounterKey)
+	if bz == nil {
+		return
+	}
+	k.codec.MustUnmarshalBinaryBare(bz, &counter)
+	return
+}
+
+func (k Keeper) setRegistrationCounter(ctx sdk.Context, counter registrationCounter) {
+	k.store(ctx).Set(RegistrationCounterKey, k.codec.MustMarshalBinaryBare(counter))
+}
@@ -15,30 +15,43 @@ const (
 	QuerierRoute      = ModuleName
 	DefaultParamspace = ModuleName
 
-	EventTypeUnjailedAccount = "unjailed_account"
-	AttributeKeyUser         = "user"
+	EventTypeUnjailedAccount  = "unjailed_account"
+	EventTypeReferralRewarded = "referral_rewarded"
+	AttributeKeyUser          = "user"
+	AttributeKeyInvitee       = "invitee"
 )
 
 type PrimaryAccount struct {
 	auth.BaseAccount
 
 	SlashCount  int       `json:"slash_count"`
+	JailCount   int       `json:"jail_count"`
 	IsJailed    bool      `json:"is_jailed"`
 	JailEndTime time.Time `json:"jail_end_time"`
 	CreatedTime time.Time `json:"created_time"`
 }
 
 // AppAccount is the main account for a ahmedaly113 user.
+// The first of its addresses is the primary one that manages the others,
+// the identity is the address it was registered with and never changes.
 type AppAccount struct {
-	Addresses   []sdk.AccAddress `json:"addresses"`
-	SlashCount  int              `json:"slash_count"`
-	IsJailed    bool             `json:"is_jailed"`
-	JailEndTime time.Time        `json:"jail_end_time"`
-	CreatedTime time.Time        `json:"created_time"`
+	Identity      sdk.AccAddress   `json:"identity"`
+	Addresses     []sdk.AccAddress `json:"addresses"`
+	SlashCount    int              `json:"slash_count"`
+	LastSlashTime time.Time        `json:"last_slash_time"`
+	JailCount     int              `json:"jail_count"`
+	IsJailed      bool             `json:"is_jailed"`
+	JailEndTime   time.Time        `json:"jail_end_time"`
+	CreatedTime   time.Time        `json:"created_time"`
+	// DeactivatedTime is set once the user closes the account,
+	// it is Closed when the last of its stakes expired
+	DeactivatedTime time.Time `json:"deactivated_time"`
+	Closed          bool      `json:"closed"`
 }
 
 func NewAppAccount(address sdk.AccAddress, createdTime time.Time) AppAccount {
 	return AppAccount{
+		Identity:    address,
 		Addresses:   []sdk.AccAddress{address},
 		SlashCount:  0,
 		IsJailed:    false,
@@ -51,16 +64,67 @@ func (acc AppAccount) PrimaryAddress() sdk.AccAddress {
 	return acc.Addresses[0]
 }
 
+// IsDeactivated tells whether the user asked to close the account
+func (acc AppAccount) IsDeactivated() bool {
+	return !acc.DeactivatedTime.IsZero()
+}
+
+// HasAddress tells whether an address is linked to the account
+func (acc AppAccount) HasAddress(address sdk.AccAddress) bool {
+	for _, linked := range acc.Addresses {
+		if linked.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// decaySlashCount forgives one slash for every period passed since the last slash
+func (acc *AppAccount) decaySlashCount(period time.Duration, now time.Time) {
+	if period <= 0 || acc.SlashCount == 0 || acc.LastSlashTime.IsZero() {
+		return
+	}
+	periods := int(now.Sub(acc.LastSlashTime) / period)
+	if periods <= 0 {
+		return
+	}
+	if periods > acc.SlashCount {
+		periods = acc.SlashCount
+	}
+	acc.SlashCount -= periods
+	// only whole periods are forgiven, the rest counts towards the next one
+	acc.LastSlashTime = acc.LastSlashTime.Add(time.Duration(periods) * period)
+}
+
 // String implements fmt.Stringer
 func (acc AppAccount) String() string {
 	return fmt.Sprintf(`
+  Identity:          %s
   Address:           %s
   SlashCount:        %d
+  JailCount:         %d
   IsJailed:          %t
   JailEndTime:       %s
   CreatedTime:       %s`,
-		acc.PrimaryAddress().String(), acc.SlashCount, acc.IsJailed, acc.JailEndTime.String(), acc.CreatedTime.String())
+		acc.Identity.String(), acc.PrimaryAddress().String(), acc.SlashCount, acc.JailCount, acc.IsJailed, acc.JailEndTime.String(), acc.CreatedTime.String())
 }
 
 // AppAccounts is a slice of AppAccounts
 type AppAccounts []AppAccount
+
+// SlashRecord is a slash counted against an AppAccount
+type SlashRecord struct {
+	SlashID uint64 `json:"slash_id"`
+	Reason  string `json:"reason"`
+}
+
+// JailEpisode is one stay of an AppAccount in jail
+type JailEpisode struct {
+	Address      sdk.AccAddress `json:"address"`
+	Number       int            `json:"number"`
+	SlashCount   int            `json:"slash_count"`
+	Slashes      []SlashRecord  `json:"slashes"`
+	StartTime    time.Time      `json:"start_time"`
+	EndTime      time.Time      `json:"end_time"`
+	ReleasedTime time.Time      `json:"released_time"`
+}
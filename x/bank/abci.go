@@ -0,0 +1,10 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, prunes transactions past their retention
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.pruneTransactions(ctx)
+}
@@ -11,6 +11,14 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	DefaultParamspace = types.DefaultParamspace
 
+	EventTypeTransactionsPruned   = types.EventTypeTransactionsPruned
+	AttributeKeyCount             = types.AttributeKeyCount
+	AttributeKeyLastTransactionID = types.AttributeKeyLastTransactionID
+
+	EventTypeGiftBatchSent = types.EventTypeGiftBatchSent
+	AttributeKeyCampaignID = types.AttributeKeyCampaignID
+	AttributeKeyAmount     = types.AttributeKeyAmount
+
 	TransactionGift                            = exported.TransactionGift
 	TransactionBacking                         = exported.TransactionBacking
 	TransactionBackingReturned                 = exported.TransactionBackingReturned
@@ -28,31 +36,62 @@ const (
 	TransactionStakeCreatorSlashed             = exported.TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed             = exported.TransactionStakeCuratorSlashed
 
-	TransactionCuratorReward = exported.TransactionCuratorReward
+	TransactionCuratorReward  = exported.TransactionCuratorReward
+	TransactionStakeWinnings  = exported.TransactionStakeWinnings
+	TransactionStakeWithdrawn = exported.TransactionStakeWithdrawn
 
-	SortAsc                    = exported.SortAsc
-	SortDesc                   = exported.SortDesc
-	QueryTransactionsByAddress = exported.QueryTransactionsByAddress
-	QueryParams                = exported.QueryParams
-	RouterKey                  = exported.RouterKey
+	TransactionStakeSlashReverted      = exported.TransactionStakeSlashReverted
+	TransactionInterestSlashReverted   = exported.TransactionInterestSlashReverted
+	TransactionCuratorRewardClawedBack = exported.TransactionCuratorRewardClawedBack
+	TransactionReferralReward          = exported.TransactionReferralReward
+	TransactionTip                     = exported.TransactionTip
+	TransactionTipSent                 = exported.TransactionTipSent
+
+	SortAsc                      = exported.SortAsc
+	SortDesc                     = exported.SortDesc
+	QueryTransactionsByAddress   = exported.QueryTransactionsByAddress
+	QueryTransactionSummary      = exported.QueryTransactionSummary
+	QueryTransactionsByReference = exported.QueryTransactionsByReference
+	QueryTransactionsByCommunity = exported.QueryTransactionsByCommunity
+	QueryTransactionsByType      = exported.QueryTransactionsByType
+	QueryParams                  = exported.QueryParams
+	RouterKey                    = exported.RouterKey
 )
 
 var (
-	GetFilters              = exported.GetFilters
-	FilterByTransactionType = exported.FilterByTransactionType
-	SortOrder               = exported.SortOrder
-	Limit                   = exported.Limit
-	Offset                  = exported.Offset
-	FromModuleAccount       = exported.FromModuleAccount
-	ToModuleAccount         = exported.ToModuleAccount
-	ModuleCodec             = types.ModuleCodec
+	GetFilters                = exported.GetFilters
+	FilterByTransactionType   = exported.FilterByTransactionType
+	FilterByTimeRange         = exported.FilterByTimeRange
+	FilterByCommunityID       = exported.FilterByCommunityID
+	FilterByReferenceID       = exported.FilterByReferenceID
+	FilterByFromModuleAccount = exported.FilterByFromModuleAccount
+	FilterByToModuleAccount   = exported.FilterByToModuleAccount
+	FilterByMemo              = exported.FilterByMemo
+	SortOrder                 = exported.SortOrder
+	Limit                     = exported.Limit
+	Offset                    = exported.Offset
+	FromModuleAccount         = exported.FromModuleAccount
+	WithCommunityID           = exported.WithCommunityID
+	WithMemo                  = exported.WithMemo
+	ToModuleAccount           = exported.ToModuleAccount
+	ModuleCodec               = types.ModuleCodec
+
+	AllowedTransactionsForEarning          = exported.AllowedTransactionsForEarning
+	AllowedTransactionsForEarningDeduction = exported.AllowedTransactionsForEarningDeduction
 )
 
 type (
-	TransactionType                  = exported.TransactionType
-	TransactionSetter                = exported.TransactionSetter
-	Filter                           = exported.Filter
-	SortOrderType                    = exported.SortOrderType
-	Transaction                      = exported.Transaction
-	QueryTransactionsByAddressParams = exported.QueryTransactionsByAddressParams
+	TransactionType                    = exported.TransactionType
+	TransactionSetter                  = exported.TransactionSetter
+	Filter                             = exported.Filter
+	SortOrderType                      = exported.SortOrderType
+	Transaction                        = exported.Transaction
+	QueryTransactionsByAddressParams   = exported.QueryTransactionsByAddressParams
+	QueryTransactionSummaryParams      = exported.QueryTransactionSummaryParams
+	TransactionSummary                 = exported.TransactionSummary
+	QueryTransactionsByReferenceParams = exported.QueryTransactionsByReferenceParams
+	QueryTransactionsByCommunityParams = exported.QueryTransactionsByCommunityParams
+	QueryTransactionsByTypeParams      = exported.QueryTransactionsByTypeParams
+	TransactionsPage                   = exported.TransactionsPage
+	TransactionRollup                  = exported.TransactionRollup
 )
@@ -6,6 +6,8 @@ import "github.com/cosmos/cosmos-sdk/codec"
 func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgSendGift{}, "ahchain/MsgSendGift", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "bank/MsgUpdateParams", nil)
+	c.RegisterConcrete(MsgTip{}, "bank/MsgTip", nil)
+	c.RegisterConcrete(MsgSendGiftBatch{}, "bank/MsgSendGiftBatch", nil)
 
 	c.RegisterConcrete(Transaction{}, "ahchain/Transaction", nil)
 }
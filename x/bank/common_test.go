@@ -70,7 +70,8 @@ func mockDB() (sdk.Context, Keeper, auth.AccountKeeper) {
 		pk.Subspace(DefaultParamspace),
 		DefaultCodespace,
 		supplyKeeper,
-	)
+	).SetTipKeepers(&mockAccountKeeper{jailed: make(map[string]bool), identities: make(map[string]sdk.AccAddress)},
+		&mockStakingKeeper{creators: make(map[uint64]sdk.AccAddress), communities: make(map[uint64]string)})
 
 	InitGenesis(ctx, keeper, DefaultGenesisState())
 	return ctx, keeper, accKeeper
@@ -91,3 +92,29 @@ func keyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
 	addr := sdk.AccAddress(pub.Address())
 	return key, pub, addr
 }
+
+type mockAccountKeeper struct {
+	jailed     map[string]bool
+	identities map[string]sdk.AccAddress
+}
+
+func (m *mockAccountKeeper) Identity(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, sdk.Error) {
+	if identity, ok := m.identities[address.String()]; ok {
+		return identity, nil
+	}
+	return address, nil
+}
+
+func (m *mockAccountKeeper) IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error) {
+	return m.jailed[address.String()], nil
+}
+
+type mockStakingKeeper struct {
+	creators    map[uint64]sdk.AccAddress
+	communities map[uint64]string
+}
+
+func (m *mockStakingKeeper) ArgumentCreator(ctx sdk.Context, argumentID uint64) (sdk.AccAddress, string, bool) {
+	creator, ok := m.creators[argumentID]
+	return creator, m.communities[argumentID], ok
+}
@@ -14,6 +14,12 @@ const (
 	ErrorCodeInvalidRewardBrokerAddress sdk.CodeType = 402
 	ErrorCodeInvalidQueryParams         sdk.CodeType = 403
 	ErrorCodeUnknownTransaction         sdk.CodeType = 404
+	ErrorCodeInvalidTip                 sdk.CodeType = 405
+	ErrorCodeUnknownArgument            sdk.CodeType = 406
+	ErrorCodeJailed                     sdk.CodeType = 407
+	ErrorCodeDailyTipLimitReached       sdk.CodeType = 408
+	ErrorCodeInvalidGiftBatch           sdk.CodeType = 409
+	ErrorCodeDailyGiftLimitReached      sdk.CodeType = 410
 )
 
 // ErrInvalidRewardBrokerAddress throws an error when the address doesn't match with genesis param address.
@@ -47,3 +53,51 @@ func ErrCodeUnknownTransaction(transactionID uint64) sdk.Error {
 		fmt.Sprintf("Unknown transaction id %d", transactionID),
 	)
 }
+
+// ErrInvalidTip throws an error when a tip is invalid
+func ErrInvalidTip(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidTip,
+		fmt.Sprintf("Invalid tip: %s", msg),
+	)
+}
+
+// ErrUnknownArgument throws an error when the tipped argument doesn't exist
+func ErrUnknownArgument(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeUnknownArgument,
+		fmt.Sprintf("Unknown argument id %d", argumentID),
+	)
+}
+
+// ErrJailed throws an error when a jailed user sends or receives a tip
+func ErrJailed(address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeJailed,
+		fmt.Sprintf("User %s is jailed", address.String()),
+	)
+}
+
+// ErrDailyTipLimitReached throws an error when a tip goes over the daily tip limit
+func ErrDailyTipLimitReached(limit sdk.Coin) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeDailyTipLimitReached,
+		fmt.Sprintf("Daily tip limit of %s reached", limit.String()),
+	)
+}
+
+// ErrInvalidGiftBatch throws an error when a gift batch is invalid
+func ErrInvalidGiftBatch(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeInvalidGiftBatch,
+		fmt.Sprintf("Invalid gift batch: %s", msg),
+	)
+}
+
+// ErrDailyGiftLimitReached throws an error when gifts go over the daily gift limit
+func ErrDailyGiftLimitReached(limit sdk.Coin) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeDailyGiftLimitReached,
+		fmt.Sprintf("Daily gift limit of %s reached", limit.String()),
+	)
+}
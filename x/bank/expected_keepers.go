@@ -0,0 +1,16 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper is the expected app account keeper interface for the module
+type AccountKeeper interface {
+	Identity(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, sdk.Error)
+	IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error)
+}
+
+// StakingKeeper is the expected staking keeper interface for the module
+type StakingKeeper interface {
+	ArgumentCreator(ctx sdk.Context, argumentID uint64) (creator sdk.AccAddress, communityID string, ok bool)
+}
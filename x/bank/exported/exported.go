@@ -3,6 +3,7 @@ package exported
 import (
 	"time"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	"github.com/ahmedaly113/ahchain/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,8 @@ type Transaction struct {
 	CreatedTime       time.Time       `json:"created_time"`
 	FromModuleAccount string          `json:"sender_module_account"`
 	ToModuleAccount   string          `json:"to_module_account"`
+	// Memo tags the transaction, like the campaign of a gift
+	Memo string `json:"memo,omitempty"`
 }
 
 // TransactionType defines the type of transaction.
@@ -43,6 +46,14 @@ const (
 	TransactionStakeCreatorSlashed
 	TransactionStakeCuratorSlashed
 	TransactionCuratorReward
+	TransactionStakeWinnings
+	TransactionStakeWithdrawn
+	TransactionStakeSlashReverted
+	TransactionInterestSlashReverted
+	TransactionCuratorRewardClawedBack
+	TransactionReferralReward
+	TransactionTip
+	TransactionTipSent
 )
 
 var TransactionTypeName = []string{
@@ -62,6 +73,15 @@ var TransactionTypeName = []string{
 	TransactionInterestUpvoteGivenSlashed:      "TransactionInterestUpvoteGivenSlashed",
 	TransactionStakeCreatorSlashed:             "TransactionStakeCreatorSlashed",
 	TransactionStakeCuratorSlashed:             "TransactionStakeCuratorSlashed",
+	TransactionCuratorReward:                   "TransactionCuratorReward",
+	TransactionStakeWinnings:                   "TransactionStakeWinnings",
+	TransactionStakeWithdrawn:                  "TransactionStakeWithdrawn",
+	TransactionStakeSlashReverted:              "TransactionStakeSlashReverted",
+	TransactionInterestSlashReverted:           "TransactionInterestSlashReverted",
+	TransactionCuratorRewardClawedBack:         "TransactionCuratorRewardClawedBack",
+	TransactionReferralReward:                  "TransactionReferralReward",
+	TransactionTip:                             "TransactionTip",
+	TransactionTipSent:                         "TransactionTipSent",
 }
 
 func (t TransactionType) String() string {
@@ -81,12 +101,20 @@ var AllowedTransactionsForAddition = []TransactionType{
 	TransactionInterestUpvoteGiven,
 	TransactionRewardPayout,
 	TransactionCuratorReward,
+	TransactionStakeWinnings,
+	TransactionStakeWithdrawn,
+	TransactionStakeSlashReverted,
+	TransactionInterestSlashReverted,
+	TransactionReferralReward,
+	TransactionTip,
 }
 
 var AllowedTransactionsForEarning = []TransactionType{
 	TransactionInterestArgumentCreation,
 	TransactionInterestUpvoteReceived,
 	TransactionInterestUpvoteGiven,
+	TransactionStakeWinnings,
+	TransactionInterestSlashReverted,
 }
 
 var AllowedTransactionsForEarningDeduction = []TransactionType{
@@ -104,6 +132,8 @@ var AllowedTransactionsForDeduction = []TransactionType{
 	TransactionInterestUpvoteGivenSlashed,
 	TransactionStakeCreatorSlashed,
 	TransactionStakeCuratorSlashed,
+	TransactionCuratorRewardClawedBack,
+	TransactionTipSent,
 }
 
 func (t TransactionType) AllowedForAddition() bool {
@@ -131,6 +161,12 @@ func WithCommunityID(communityID string) TransactionSetter {
 	}
 }
 
+func WithMemo(memo string) TransactionSetter {
+	return func(tx *Transaction) {
+		tx.Memo = memo
+	}
+}
+
 func FromModuleAccount(moduleAccount string) TransactionSetter {
 	return func(tx *Transaction) {
 		tx.FromModuleAccount = moduleAccount
@@ -159,6 +195,50 @@ type Filters struct {
 	SortOrder        SortOrderType
 	Limit            int
 	Offset           int
+	// StartTime and EndTime bound the created time, zero times leave the range open
+	StartTime         time.Time
+	EndTime           time.Time
+	CommunityID       string
+	ReferenceID       *uint64
+	FromModuleAccount string
+	ToModuleAccount   string
+	Memo              string
+}
+
+// Match tells whether a transaction passes the filters, sort order, limit and offset aside
+func (f Filters) Match(tx Transaction) bool {
+	if len(f.TransactionTypes) > 0 && !tx.Type.OneOf(f.TransactionTypes) {
+		return false
+	}
+	if f.BeforeRange(tx) || f.AfterRange(tx) {
+		return false
+	}
+	if f.CommunityID != "" && tx.CommunityID != f.CommunityID {
+		return false
+	}
+	if f.ReferenceID != nil && tx.ReferenceID != *f.ReferenceID {
+		return false
+	}
+	if f.FromModuleAccount != "" && tx.FromModuleAccount != f.FromModuleAccount {
+		return false
+	}
+	if f.ToModuleAccount != "" && tx.ToModuleAccount != f.ToModuleAccount {
+		return false
+	}
+	if f.Memo != "" && tx.Memo != f.Memo {
+		return false
+	}
+	return true
+}
+
+// BeforeRange tells whether a transaction was created before StartTime
+func (f Filters) BeforeRange(tx Transaction) bool {
+	return !f.StartTime.IsZero() && tx.CreatedTime.Before(f.StartTime)
+}
+
+// AfterRange tells whether a transaction was created at or after EndTime
+func (f Filters) AfterRange(tx Transaction) bool {
+	return !f.EndTime.IsZero() && !tx.CreatedTime.Before(f.EndTime)
 }
 
 type Filter func(*Filters)
@@ -196,6 +276,44 @@ func Offset(offset int) Filter {
 	}
 }
 
+// FilterByTimeRange keeps transactions created from start until end
+func FilterByTimeRange(start, end time.Time) Filter {
+	return func(filters *Filters) {
+		filters.StartTime = start
+		filters.EndTime = end
+	}
+}
+
+func FilterByCommunityID(communityID string) Filter {
+	return func(filters *Filters) {
+		filters.CommunityID = communityID
+	}
+}
+
+func FilterByReferenceID(referenceID uint64) Filter {
+	return func(filters *Filters) {
+		filters.ReferenceID = &referenceID
+	}
+}
+
+func FilterByFromModuleAccount(moduleAccount string) Filter {
+	return func(filters *Filters) {
+		filters.FromModuleAccount = moduleAccount
+	}
+}
+
+func FilterByToModuleAccount(moduleAccount string) Filter {
+	return func(filters *Filters) {
+		filters.ToModuleAccount = moduleAccount
+	}
+}
+
+func FilterByMemo(memo string) Filter {
+	return func(filters *Filters) {
+		filters.Memo = memo
+	}
+}
+
 func GetFilters(filterSetters ...Filter) Filters {
 	filters := Filters{
 		TransactionTypes: make([]TransactionType, 0),
@@ -211,13 +329,17 @@ func GetFilters(filterSetters ...Filter) Filters {
 
 // Defines bank module constants
 const (
-	QueryTransactionsByAddress = "transactions_by_address"
-	QueryParams                = "params"
-	ModuleName                 = types.ModuleName
-	StoreKey                   = ModuleName
-	RouterKey                  = ModuleName
-	QuerierRoute               = ModuleName
-	DefaultParamspace          = ModuleName
+	QueryTransactionsByAddress   = "transactions_by_address"
+	QueryTransactionSummary      = "transaction_summary"
+	QueryTransactionsByReference = "transactions_by_reference"
+	QueryTransactionsByCommunity = "transactions_by_community"
+	QueryTransactionsByType      = "transactions_by_type"
+	QueryParams                  = "params"
+	ModuleName                   = types.ModuleName
+	StoreKey                     = ModuleName
+	RouterKey                    = ModuleName
+	QuerierRoute                 = ModuleName
+	DefaultParamspace            = ModuleName
 )
 
 // QueryTransactionsByAddress query transactions params for a specific address.
@@ -227,4 +349,62 @@ type QueryTransactionsByAddressParams struct {
 	SortOrder SortOrderType     `json:"sort_order,omitempty"`
 	Limit     int               `json:"limit,omitempty"`
 	Offset    int               `json:"offset,omitempty"`
+
+	StartTime         time.Time `json:"start_time,omitempty"`
+	EndTime           time.Time `json:"end_time,omitempty"`
+	CommunityID       string    `json:"community_id,omitempty"`
+	ReferenceID       *uint64   `json:"reference_id,omitempty"`
+	FromModuleAccount string    `json:"from_module_account,omitempty"`
+	ToModuleAccount   string    `json:"to_module_account,omitempty"`
+	Memo              string    `json:"memo,omitempty"`
+}
+
+// QueryTransactionSummaryParams are the params to sum the transactions of an address
+type QueryTransactionSummaryParams struct {
+	Address     sdk.AccAddress    `json:"address"`
+	Types       []TransactionType `json:"types,omitempty"`
+	CommunityID string            `json:"community_id,omitempty"`
+	StartTime   time.Time         `json:"start_time,omitempty"`
+	EndTime     time.Time         `json:"end_time,omitempty"`
+}
+
+// QueryTransactionsByReferenceParams are the params to page through the transactions of a reference id
+type QueryTransactionsByReferenceParams struct {
+	ReferenceID uint64         `json:"reference_id"`
+	Pagination  app.Pagination `json:"pagination"`
+}
+
+// QueryTransactionsByCommunityParams are the params to page through the transactions of a community
+type QueryTransactionsByCommunityParams struct {
+	CommunityID string         `json:"community_id"`
+	Pagination  app.Pagination `json:"pagination"`
+}
+
+// QueryTransactionsByTypeParams are the params to page through the transactions of a type
+type QueryTransactionsByTypeParams struct {
+	Type       TransactionType `json:"type"`
+	Pagination app.Pagination  `json:"pagination"`
+}
+
+// TransactionsPage is a page of transactions with the cursor of the next one
+type TransactionsPage struct {
+	Transactions []Transaction `json:"transactions"`
+	NextCursor   string        `json:"next_cursor"`
+}
+
+// TransactionRollup is the lifetime total of the pruned transactions of a user by type and community
+type TransactionRollup struct {
+	Address     sdk.AccAddress  `json:"address"`
+	Type        TransactionType `json:"type"`
+	CommunityID string          `json:"community_id"`
+	Count       int             `json:"count"`
+	Amount      sdk.Coin        `json:"amount"`
+}
+
+// TransactionSummary is the count and total amount of the transactions of a type in a community
+type TransactionSummary struct {
+	Type        TransactionType `json:"type"`
+	CommunityID string          `json:"community_id"`
+	Count       int             `json:"count"`
+	Amount      sdk.Coin        `json:"amount"`
 }
@@ -2,14 +2,17 @@ package bank
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Transactions []Transaction `json:"transactions"`
-	Params       Params        `json:"params"`
+	Transactions  []Transaction       `json:"transactions"`
+	Rollups       []TransactionRollup `json:"rollups"`
+	TransactionID uint64              `json:"transaction_id"`
+	Params        Params              `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,6 +28,7 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params:       DefaultParams(),
 		Transactions: make([]Transaction, 0),
+		Rollups:      make([]TransactionRollup, 0),
 	}
 }
 
@@ -34,15 +38,33 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, tx := range data.Transactions {
 		keeper.setTransaction(ctx, tx)
 		keeper.setUserTransaction(ctx, tx.AppAccountAddress, tx.CreatedTime, tx.ID)
+		keeper.addEarned(ctx, tx.AppAccountAddress, tx.Type, tx.Amount.Amount)
 	}
-	keeper.setTransactionID(ctx, uint64(len(data.Transactions)+1))
+	for _, rollup := range data.Rollups {
+		keeper.setUserRollup(ctx, rollup)
+		keeper.addEarned(ctx, rollup.Address, rollup.Type, rollup.Amount.Amount)
+	}
+	// pruned transactions leave gaps, so ids continue after the highest one
+	transactionID := uint64(len(data.Transactions) + 1)
+	for _, tx := range data.Transactions {
+		if tx.ID >= transactionID {
+			transactionID = tx.ID + 1
+		}
+	}
+	if data.TransactionID > transactionID {
+		transactionID = data.TransactionID
+	}
+	keeper.setTransactionID(ctx, transactionID)
 }
 
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	transactionID, _ := keeper.transactionID(ctx)
 	return GenesisState{
-		Params:       keeper.GetParams(ctx),
-		Transactions: keeper.Transactions(ctx),
+		Params:        keeper.GetParams(ctx),
+		Transactions:  keeper.Transactions(ctx),
+		Rollups:       keeper.Rollups(ctx),
+		TransactionID: transactionID,
 	}
 }
 
@@ -51,5 +73,23 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.RewardBrokerAddress.Empty() {
 		return fmt.Errorf("param: RewardBrokerAddress, a valid address must be provided")
 	}
+	if !data.Params.DailyTipLimit.IsValid() {
+		return fmt.Errorf("param: DailyTipLimit, must be a valid coin")
+	}
+	if data.Params.TransactionRetention != 0 && data.Params.TransactionRetention < 24*time.Hour {
+		return fmt.Errorf("param: TransactionRetention, must be 0 or at least a day")
+	}
+	if data.Params.PruneBatchSize < 0 {
+		return fmt.Errorf("param: PruneBatchSize, must not be negative")
+	}
+	if data.Params.MaxGiftBatchSize <= 0 {
+		return fmt.Errorf("param: MaxGiftBatchSize, must be positive")
+	}
+	if !data.Params.GiftBatchLimit.IsValid() {
+		return fmt.Errorf("param: GiftBatchLimit, must be a valid coin")
+	}
+	if !data.Params.DailyGiftLimit.IsValid() {
+		return fmt.Errorf("param: DailyGiftLimit, must be a valid coin")
+	}
 	return nil
 }
@@ -16,9 +16,8 @@ func TestInitGenesis(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 	_, _, rewardAddr := keyPubAddr()
 	_, _, appAccountAddr := keyPubAddr()
-	params := Params{
-		RewardBrokerAddress: rewardAddr,
-	}
+	params := DefaultParams()
+	params.RewardBrokerAddress = rewardAddr
 
 	regTx := Transaction{
 		ID:                1,
@@ -39,6 +38,16 @@ func TestInitGenesis(t *testing.T) {
 	}
 	transactions := []Transaction{regTx, backTx}
 	genesisState := NewGenesisState(params, transactions)
+	genesisState.Rollups = []TransactionRollup{
+		{
+			Address: appAccountAddr,
+			Type:    TransactionGift,
+			Count:   2,
+			Amount:  sdk.NewInt64Coin("mydenom", 200),
+		},
+	}
+	// ids of pruned transactions are not reused
+	genesisState.TransactionID = 10
 	InitGenesis(ctx, keeper, genesisState)
 	actualGenesis := ExportGenesis(ctx, keeper)
 	assert.Equal(t, genesisState, actualGenesis)
@@ -53,4 +62,22 @@ func TestInitGenesis(t *testing.T) {
 	accountTxs := keeper.TransactionsByAddress(ctx, appAccountAddr)
 	assert.Equal(t, transactions, accountTxs)
 
+	// rollups are part of the lifetime totals
+	summaries := keeper.TransactionSummary(ctx, appAccountAddr, FilterByTransactionType(TransactionGift))
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, 3, summaries[0].Count)
+	assert.Equal(t, sdk.NewInt64Coin("mydenom", 500), summaries[0].Amount)
+
+	// earned totals are rebuilt from the transactions and rollups
+	assert.True(t, keeper.EarnedCoins(ctx, appAccountAddr).IsZero())
+	_, _, earnerAddr := keyPubAddr()
+	InitGenesis(ctx, keeper, GenesisState{
+		Params: params,
+		Transactions: []Transaction{{ID: 11, Type: TransactionStakeWinnings, AppAccountAddress: earnerAddr,
+			Amount: sdk.NewInt64Coin("mydenom", 30), CreatedTime: ctx.BlockHeader().Time}},
+		Rollups: []TransactionRollup{{Address: earnerAddr, Type: TransactionStakeWinnings, Count: 1,
+			Amount: sdk.NewInt64Coin("mydenom", 20)}},
+	})
+	assert.Equal(t, sdk.NewInt(50), keeper.EarnedCoins(ctx, earnerAddr))
+
 }
@@ -0,0 +1,91 @@
+package bank
+
+import (
+	"fmt"
+	"time"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/distribution"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// dailyGifts is the total the reward broker gifted since the start of Day
+type dailyGifts struct {
+	Day    time.Time `json:"day"`
+	Amount sdk.Int   `json:"amount"`
+}
+
+// sendGiftBatch gifts coins from the user growth pool to many users at once and returns the total sent.
+// Only the reward broker can send it, a batch can have up to MaxGiftBatchSize recipients
+// and send up to GiftBatchLimit, and the gifts of a day are capped by DailyGiftLimit.
+// Gifts are checked before any is sent, a failing gift reverts the whole message.
+// Each gift transaction carries the campaign ID as its memo.
+func (k Keeper) sendGiftBatch(ctx sdk.Context, sender sdk.AccAddress,
+	gifts []Gift, campaignID string) (sdk.Coin, sdk.Error) {
+	if !k.rewardBrokerAddress(ctx).Equals(sender) {
+		return sdk.Coin{}, ErrInvalidRewardBrokerAddress(sender)
+	}
+	params := k.GetParams(ctx)
+	if len(gifts) > params.MaxGiftBatchSize {
+		return sdk.Coin{}, ErrInvalidGiftBatch(fmt.Sprintf("more than %d recipients", params.MaxGiftBatchSize))
+	}
+	total := sdk.ZeroInt()
+	for _, gift := range gifts {
+		if gift.Amount.Denom != app.StakeDenom {
+			return sdk.Coin{}, sdk.ErrInvalidCoins("Invalid denomination coin")
+		}
+		total = total.Add(gift.Amount.Amount)
+	}
+	if total.GT(params.GiftBatchLimit.Amount) {
+		return sdk.Coin{}, ErrInvalidGiftBatch(fmt.Sprintf("gifts over the batch limit of %s", params.GiftBatchLimit))
+	}
+	if err := k.addDailyGifts(ctx, total); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	for _, gift := range gifts {
+		_, err := k.AddCoin(ctx, gift.Recipient, gift.Amount, 0, TransactionGift,
+			FromModuleAccount(distribution.UserGrowthPoolName), WithMemo(campaignID))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	sent := sdk.NewCoin(app.StakeDenom, total)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeGiftBatchSent,
+			sdk.NewAttribute(AttributeKeyCampaignID, campaignID),
+			sdk.NewAttribute(AttributeKeyCount, fmt.Sprintf("%d", len(gifts))),
+			sdk.NewAttribute(AttributeKeyAmount, sent.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Sent %d gifts of %s for campaign %q", len(gifts), sent, campaignID))
+
+	return sent, nil
+}
+
+// addDailyGifts adds an amount to the gifts of the UTC day, up to DailyGiftLimit
+func (k Keeper) addDailyGifts(ctx sdk.Context, amount sdk.Int) sdk.Error {
+	limit := k.GetParams(ctx).DailyGiftLimit
+	day := ctx.BlockHeader().Time.Truncate(24 * time.Hour)
+	gifted := k.dailyGifts(ctx)
+	if !gifted.Day.Equal(day) {
+		gifted = dailyGifts{Day: day, Amount: sdk.ZeroInt()}
+	}
+	gifted.Amount = gifted.Amount.Add(amount)
+	if gifted.Amount.GT(limit.Amount) {
+		return ErrDailyGiftLimitReached(limit)
+	}
+	k.store(ctx).Set(DailyGiftsKey, k.codec.MustMarshalBinaryBare(gifted))
+	return nil
+}
+
+func (k Keeper) dailyGifts(ctx sdk.Context) (gifted dailyGifts) {
+	bz := k.store(ctx).Get(DailyGiftsKey)
+	if bz == nil {
+		return dailyGifts{Amount: sdk.ZeroInt()}
+	}
+	k.codec.MustUnmarshalBinaryBare(bz, &gifted)
+	return gifted
+}
@@ -15,6 +15,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSendGift(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgTip:
+			return handleMsgTip(ctx, keeper, msg)
+		case MsgSendGiftBatch:
+			return handleMsgSendGiftBatch(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -54,3 +58,43 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgTip(ctx sdk.Context, keeper Keeper, msg MsgTip) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	sent, _, err := keeper.Tip(ctx, msg.Sender, msg.Recipient, msg.ArgumentID, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := keeper.codec.MarshalJSON(sent)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSendGiftBatch(ctx sdk.Context, keeper Keeper, msg MsgSendGiftBatch) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	sent, err := keeper.sendGiftBatch(ctx, msg.Sender, msg.Gifts, msg.CampaignID)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := keeper.codec.MarshalJSON(sent)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
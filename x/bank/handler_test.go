@@ -2,6 +2,7 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,13 +27,19 @@ func TestHandle_MsgSendGift(t *testing.T) {
 
 	assert.Equal(t, ErrorCodeInvalidRewardBrokerAddress, res.Code)
 	assert.Equal(t, DefaultCodespace, res.Codespace)
-	p := Params{RewardBrokerAddress: brokerAddress}
+	p := DefaultParams()
+	p.RewardBrokerAddress = brokerAddress
+	p.DailyGiftLimit = app.NewShanevCoin(20)
 	keeper.SetParams(ctx, p)
 	res = handler(ctx, msg)
 	assert.True(t, res.IsOK())
 
 	recipientCoins := keeper.bankKeeper.GetCoins(ctx, recipientAddr)
 	assert.True(t, recipientCoins.AmountOf(app.StakeDenom).Equal(sdk.NewInt(app.Shanev*15)))
+
+	// single gifts count towards the daily limit too
+	res = handler(ctx, msg)
+	assert.Equal(t, ErrorCodeDailyGiftLimitReached, res.Code)
 }
 
 func TestMsgSendGift_Invalid(t *testing.T) {
@@ -57,6 +64,60 @@ func TestMsgSendGift_Invalid(t *testing.T) {
 
 }
 
+func TestHandle_MsgSendGiftBatch(t *testing.T) {
+	ctx, keeper, ak := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	handler := NewHandler(keeper)
+
+	brokerAddress := createFakeFundedAccount(ctx, ak, sdk.Coins{})
+	recipients := []sdk.AccAddress{
+		createFakeFundedAccount(ctx, ak, sdk.Coins{}),
+		createFakeFundedAccount(ctx, ak, sdk.Coins{}),
+		createFakeFundedAccount(ctx, ak, sdk.Coins{}),
+	}
+	gifts := func(amounts ...int64) []Gift {
+		gifts := make([]Gift, 0, len(amounts))
+		for i, amount := range amounts {
+			gifts = append(gifts, Gift{Recipient: recipients[i], Amount: app.NewShanevCoin(amount)})
+		}
+		return gifts
+	}
+
+	res := handler(ctx, NewMsgSendGiftBatch(brokerAddress, gifts(10, 15), "launch"))
+	assert.Equal(t, ErrorCodeInvalidRewardBrokerAddress, res.Code)
+
+	p := DefaultParams()
+	p.RewardBrokerAddress = brokerAddress
+	p.MaxGiftBatchSize = 2
+	p.GiftBatchLimit = app.NewShanevCoin(30)
+	p.DailyGiftLimit = app.NewShanevCoin(50)
+	keeper.SetParams(ctx, p)
+
+	res = handler(ctx, NewMsgSendGiftBatch(brokerAddress, gifts(1, 1, 1), "launch"))
+	assert.Equal(t, ErrorCodeInvalidGiftBatch, res.Code)
+	res = handler(ctx, NewMsgSendGiftBatch(brokerAddress, gifts(20, 20), "launch"))
+	assert.Equal(t, ErrorCodeInvalidGiftBatch, res.Code)
+
+	res = handler(ctx, NewMsgSendGiftBatch(brokerAddress, gifts(10, 15), "launch"))
+	assert.True(t, res.IsOK())
+	var sent sdk.Coin
+	keeper.codec.MustUnmarshalJSON(res.Data, &sent)
+	assert.Equal(t, app.NewShanevCoin(25), sent)
+	assert.Equal(t, app.NewShanevCoin(10).Amount, keeper.GetCoins(ctx, recipients[0]).AmountOf(app.StakeDenom))
+	assert.Equal(t, app.NewShanevCoin(15).Amount, keeper.GetCoins(ctx, recipients[1]).AmountOf(app.StakeDenom))
+	giftTxs := keeper.TransactionsByAddress(ctx, recipients[1], FilterByTransactionType(TransactionGift))
+	assert.Len(t, giftTxs, 1)
+	assert.Equal(t, "launch", giftTxs[0].Memo)
+	assert.Len(t, keeper.TransactionsByAddress(ctx, recipients[1], FilterByMemo("other")), 0)
+	assert.Equal(t, EventTypeGiftBatchSent, res.Events[len(res.Events)-1].Type)
+
+	// 25 of the 50 daily limit is used up
+	res = handler(ctx, NewMsgSendGiftBatch(brokerAddress, gifts(15, 15), "launch"))
+	assert.Equal(t, ErrorCodeDailyGiftLimitReached, res.Code)
+	res = handler(ctx.WithBlockTime(ctx.BlockHeader().Time.AddDate(0, 0, 1)), NewMsgSendGiftBatch(brokerAddress, gifts(15, 15), "launch"))
+	assert.True(t, res.IsOK())
+}
+
 func TestByzantineMsg(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 
@@ -0,0 +1,47 @@
+package bank
+
+import (
+	"fmt"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transactionsPage returns a page of the transactions indexed under prefix, oldest first by default
+func (k Keeper) transactionsPage(ctx sdk.Context, prefix []byte, pagination app.Pagination) (TransactionsPage, sdk.Error) {
+	page := TransactionsPage{Transactions: make([]Transaction, 0)}
+	var indexErr sdk.Error
+	next, err := app.PaginateStore(k.store(ctx), prefix, pagination, app.SortAsc, func(value []byte) bool {
+		if indexErr != nil {
+			return false
+		}
+		transaction, err := k.indexedTransaction(ctx, value)
+		if err != nil {
+			indexErr = err
+			return false
+		}
+		page.Transactions = append(page.Transactions, transaction)
+		return true
+	})
+	if err != nil {
+		return page, ErrInvalidQueryParams(err)
+	}
+	if indexErr != nil {
+		return page, indexErr
+	}
+	page.NextCursor = next
+	return page, nil
+}
+
+// indexedTransaction returns the transaction an index entry points to
+func (k Keeper) indexedTransaction(ctx sdk.Context, bz []byte) (Transaction, sdk.Error) {
+	var transactionID uint64
+	if err := k.codec.UnmarshalBinaryBare(bz, &transactionID); err != nil {
+		return Transaction{}, sdk.ErrInternal(fmt.Sprintf("invalid transaction index entry: %s", err))
+	}
+	transaction, ok := k.getTransaction(ctx, transactionID)
+	if !ok {
+		return Transaction{}, sdk.ErrInternal(fmt.Sprintf("unable to retrieve transaction with id %d", transactionID))
+	}
+	return transaction, nil
+}
@@ -1,6 +1,8 @@
 package bank
 
 import (
+	"sort"
+
 	app "github.com/ahmedaly113/ahchain/types"
 	"github.com/ahmedaly113/ahchain/x/distribution"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // Keeper is the model object for the package bank module
@@ -19,6 +22,9 @@ type Keeper struct {
 	bankKeeper   bank.Keeper
 	codespace    sdk.CodespaceType
 	supplyKeeper supply.Keeper
+
+	accountKeeper AccountKeeper
+	stakingKeeper StakingKeeper
 }
 
 // NewKeeper creates a bank keeper.
@@ -34,6 +40,14 @@ func NewKeeper(codec *codec.Codec, storeKey sdk.StoreKey, bankKeeper bank.Keeper
 	}
 }
 
+// SetTipKeepers sets the keepers tips are checked against. Both depend
+// on the bank keeper, so they are set once they have been created.
+func (k Keeper) SetTipKeepers(accountKeeper AccountKeeper, stakingKeeper StakingKeeper) Keeper {
+	k.accountKeeper = accountKeeper
+	k.stakingKeeper = stakingKeeper
+	return k
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -75,6 +89,7 @@ func (k Keeper) AddCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 	k.setTransaction(ctx, tx)
 	k.setTransactionID(ctx, transactionID+1)
 	k.setUserTransaction(ctx, addr, tx.CreatedTime, tx.ID)
+	k.addEarned(ctx, addr, txType, amt.Amount)
 	return coins, nil
 }
 
@@ -115,6 +130,7 @@ func (k Keeper) SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 	k.setTransaction(ctx, tx)
 	k.setTransactionID(ctx, transactionID+1)
 	k.setUserTransaction(ctx, addr, tx.CreatedTime, tx.ID)
+	k.addEarned(ctx, addr, txType, amt.Amount)
 	return coins, nil
 }
 
@@ -164,7 +180,11 @@ func (k Keeper) sendGift(ctx sdk.Context,
 	if amount.Denom != app.StakeDenom {
 		return sdk.ErrInvalidCoins("Invalid denomination coin")
 	}
-	_, err := k.AddCoin(ctx, recipient, amount, 0, TransactionGift, FromModuleAccount(distribution.UserGrowthPoolName))
+	err := k.addDailyGifts(ctx, amount.Amount)
+	if err != nil {
+		return err
+	}
+	_, err = k.AddCoin(ctx, recipient, amount, 0, TransactionGift, FromModuleAccount(distribution.UserGrowthPoolName))
 	if err != nil {
 		return err
 	}
@@ -175,27 +195,41 @@ func (k Keeper) sendGift(ctx sdk.Context,
 // Transactions gets all the transactions
 func (k Keeper) Transactions(ctx sdk.Context) []Transaction {
 	transactions := make([]Transaction, 0)
+	k.IterateTransactions(ctx, func(transaction Transaction) bool {
+		transactions = append(transactions, transaction)
+		return false
+	})
+	return transactions
+}
+
+// IterateTransactions iterates over all the transactions by id
+func (k Keeper) IterateTransactions(ctx sdk.Context, cb func(transaction Transaction) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), TransactionsKeyPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var transaction Transaction
 		k.codec.MustUnmarshalBinaryBare(iterator.Value(), &transaction)
-		transactions = append(transactions, transaction)
+		if cb(transaction) {
+			break
+		}
 	}
-	return transactions
 }
 
 // TransactionsByAddress gets transactions for a given address and applies sent filters.
 func (k Keeper) TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, filterSetters ...Filter) []Transaction {
 	filters := GetFilters(filterSetters...)
 	transactions := make([]Transaction, 0)
-	filterByType := len(filters.TransactionTypes) > 0
 
+	reverse := filters.SortOrder == SortDesc
 	offsetCount := filters.Offset
 	count := 0
 	callbackFunc := func(tx Transaction) bool {
-		if filterByType && !tx.Type.OneOf(filters.TransactionTypes) {
-			return false
+		if !filters.Match(tx) {
+			// transactions are ordered by created time, none are left in range past its end
+			if reverse {
+				return filters.BeforeRange(tx)
+			}
+			return filters.AfterRange(tx)
 		}
 		if offsetCount > 0 {
 			offsetCount = offsetCount - 1
@@ -208,10 +242,84 @@ func (k Keeper) TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, f
 		transactions = append(transactions, tx)
 		return false
 	}
-	k.IterateUserTransactions(ctx, address, filters.SortOrder == SortDesc, callbackFunc)
+	k.IterateUserTransactions(ctx, address, reverse, callbackFunc)
 	return transactions
 }
 
+// TransactionSummary sums the transactions of an address that pass the filters
+// by type and community. Sort order, limit and offset are ignored. Without a time
+// range or reference and module account filters the totals are lifetime totals,
+// which include the rollups of pruned transactions.
+func (k Keeper) TransactionSummary(ctx sdk.Context, address sdk.AccAddress, filterSetters ...Filter) []TransactionSummary {
+	filters := GetFilters(filterSetters...)
+	summaries := make([]TransactionSummary, 0)
+	add := func(txType TransactionType, communityID string, count int, amount sdk.Coin) {
+		for i, summary := range summaries {
+			if summary.Type == txType && summary.CommunityID == communityID {
+				summaries[i].Count += count
+				summaries[i].Amount = summary.Amount.Add(amount)
+				return
+			}
+		}
+		summaries = append(summaries, TransactionSummary{
+			Type:        txType,
+			CommunityID: communityID,
+			Count:       count,
+			Amount:      amount,
+		})
+	}
+	lifetime := filters.StartTime.IsZero() && filters.EndTime.IsZero() && filters.ReferenceID == nil &&
+		filters.FromModuleAccount == "" && filters.ToModuleAccount == "" && filters.Memo == ""
+	if lifetime {
+		k.IterateUserRollups(ctx, address, func(rollup TransactionRollup) bool {
+			rolledUp := Transaction{Type: rollup.Type, CommunityID: rollup.CommunityID}
+			if filters.Match(rolledUp) {
+				add(rollup.Type, rollup.CommunityID, rollup.Count, rollup.Amount)
+			}
+			return false
+		})
+	}
+	k.IterateUserTransactions(ctx, address, false, func(tx Transaction) bool {
+		if !filters.Match(tx) {
+			return filters.AfterRange(tx)
+		}
+		add(tx.Type, tx.CommunityID, 1, tx.Amount)
+		return false
+	})
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Type != summaries[j].Type {
+			return summaries[i].Type < summaries[j].Type
+		}
+		return summaries[i].CommunityID < summaries[j].CommunityID
+	})
+	return summaries
+}
+
+// EarnedCoins returns the lifetime earnings minus earning deductions of a user.
+// It is kept as a running total, so pruning and long histories don't affect the cost.
+func (k Keeper) EarnedCoins(ctx sdk.Context, address sdk.AccAddress) sdk.Int {
+	bz := k.store(ctx).Get(userEarnedKey(address))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var earned sdk.Int
+	k.codec.MustUnmarshalBinaryBare(bz, &earned)
+	return earned
+}
+
+// addEarned adds an earning to the running earned total of a user, or subtracts an earning deduction
+func (k Keeper) addEarned(ctx sdk.Context, address sdk.AccAddress, txType TransactionType, amount sdk.Int) {
+	switch {
+	case txType.OneOf(AllowedTransactionsForEarning):
+	case txType.OneOf(AllowedTransactionsForEarningDeduction):
+		amount = amount.Neg()
+	default:
+		return
+	}
+	earned := k.EarnedCoins(ctx, address).Add(amount)
+	k.store(ctx).Set(userEarnedKey(address), k.codec.MustMarshalBinaryBare(earned))
+}
+
 func (k Keeper) transactionID(ctx sdk.Context) (uint64, sdk.Error) {
 	id, err := k.getID(ctx, TransactionIDKey)
 	if err != nil {
@@ -239,6 +347,11 @@ func (k Keeper) getID(ctx sdk.Context, key []byte) (uint64, sdk.Error) {
 	return id, nil
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", ModuleName)
+}
+
 func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
 	return gaskv.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), ctx.GasMeter(), app.KVGasConfig())
 }
@@ -253,7 +366,18 @@ func (k Keeper) getTransaction(ctx sdk.Context, transactionID uint64) (Transacti
 	return transaction, true
 }
 
+// setTransaction stores a transaction along with its reference, community and type indexes
 func (k Keeper) setTransaction(ctx sdk.Context, transaction Transaction) {
 	bz := k.codec.MustMarshalBinaryBare(transaction)
 	k.store(ctx).Set(transactionKey(transaction.ID), bz)
+
+	id := k.codec.MustMarshalBinaryBare(transaction.ID)
+	// reference id 0 means the transaction has no reference
+	if transaction.ReferenceID != 0 {
+		k.store(ctx).Set(referenceTransactionKey(transaction.ReferenceID, transaction.ID), id)
+	}
+	if transaction.CommunityID != "" {
+		k.store(ctx).Set(communityTransactionKey(transaction.CommunityID, transaction.ID), id)
+	}
+	k.store(ctx).Set(typeTransactionKey(transaction.Type, transaction.ID), id)
 }
@@ -2,11 +2,13 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/account"
 	"github.com/ahmedaly113/ahchain/x/bank/exported"
 )
 
@@ -226,3 +228,231 @@ func TestKeeper_TransactionsByAddress(t *testing.T) {
 		txTypes)
 
 }
+
+func TestKeeper_Tip(t *testing.T) {
+	ctx, k, accKeeper := mockDB()
+	ctx = ctx.WithBlockTime(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	sender := createFakeFundedAccount(ctx, accKeeper, sdk.NewCoins(app.NewShanevCoin(300)))
+	recipient := createFakeFundedAccount(ctx, accKeeper, sdk.NewCoins())
+	creator := createFakeFundedAccount(ctx, accKeeper, sdk.NewCoins())
+	k.stakingKeeper.(*mockStakingKeeper).creators[7] = creator
+	k.stakingKeeper.(*mockStakingKeeper).communities[7] = "crypto"
+
+	sent, received, err := k.Tip(ctx, sender, recipient, 0, app.NewShanevCoin(40))
+	assert.NoError(t, err)
+	assert.Equal(t, TransactionTipSent, sent.Type)
+	assert.Equal(t, sender, sent.AppAccountAddress)
+	assert.Equal(t, TransactionTip, received.Type)
+	assert.Equal(t, recipient, received.AppAccountAddress)
+	assert.Equal(t, app.NewShanevCoin(40).Amount, k.GetCoins(ctx, recipient).AmountOf(app.StakeDenom))
+
+	_, received, err = k.Tip(ctx, sender, nil, 7, app.NewShanevCoin(50))
+	assert.NoError(t, err)
+	assert.Equal(t, creator, received.AppAccountAddress)
+	assert.Equal(t, uint64(7), received.ReferenceID)
+	assert.Equal(t, "crypto", received.CommunityID)
+
+	_, _, err = k.Tip(ctx, sender, nil, 8, app.NewShanevCoin(1))
+	assert.Equal(t, ErrorCodeUnknownArgument, err.Code())
+
+	_, _, err = k.Tip(ctx, sender, sender, 0, app.NewShanevCoin(1))
+	assert.Equal(t, ErrorCodeInvalidTip, err.Code())
+	// a key linked to the sender's account is the sender
+	_, _, linkedKey := keyPubAddr()
+	k.accountKeeper.(*mockAccountKeeper).identities[linkedKey.String()] = sender
+	_, _, err = k.Tip(ctx, sender, linkedKey, 0, app.NewShanevCoin(1))
+	assert.Equal(t, ErrorCodeInvalidTip, err.Code())
+	// and tips sent with it count against the sender's daily limit
+	sent, _, err = k.Tip(ctx, linkedKey, recipient, 0, app.NewShanevCoin(10))
+	assert.NoError(t, err)
+	assert.Equal(t, sender, sent.AppAccountAddress)
+
+	// the default 100 daily limit is used up
+	_, _, err = k.Tip(ctx, sender, recipient, 0, app.NewShanevCoin(1))
+	assert.Equal(t, ErrorCodeDailyTipLimitReached, err.Code())
+	_, _, err = k.Tip(ctx.WithBlockTime(ctx.BlockHeader().Time.AddDate(0, 0, 1)), sender, recipient, 0, app.NewShanevCoin(20))
+	assert.NoError(t, err)
+
+	k.accountKeeper.(*mockAccountKeeper).jailed[recipient.String()] = true
+	_, _, err = k.Tip(ctx, sender, recipient, 0, app.NewShanevCoin(1))
+	assert.Equal(t, ErrorCodeJailed, err.Code())
+}
+
+func TestKeeper_TransactionsByAddressFilters(t *testing.T) {
+	ctx, k, auth := mockDB()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := createFakeFundedAccount(ctx, auth, sdk.NewCoins(app.NewShanevCoin(100)))
+	amount := app.NewShanevCoin(10)
+
+	_, err := k.AddCoin(ctx.WithBlockTime(start), addr, amount, 1, TransactionGift, FromModuleAccount(account.UserGrowthPoolName))
+	assert.NoError(t, err)
+	_, err = k.SubtractCoin(ctx.WithBlockTime(start.Add(time.Hour)), addr, amount, 2, TransactionBacking, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	_, err = k.SubtractCoin(ctx.WithBlockTime(start.Add(2*time.Hour)), addr, amount, 3, TransactionUpvote, exported.WithCommunityID("sports"))
+	assert.NoError(t, err)
+	_, err = k.AddCoin(ctx.WithBlockTime(start.Add(3*time.Hour)), addr, amount, 2, TransactionBackingReturned, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+
+	txTypes := func(txs []Transaction) []TransactionType {
+		types := make([]TransactionType, 0)
+		for _, tx := range txs {
+			types = append(types, tx.Type)
+		}
+		return types
+	}
+
+	txs := k.TransactionsByAddress(ctx, addr, FilterByTimeRange(start.Add(time.Hour), start.Add(3*time.Hour)))
+	assert.Equal(t, []TransactionType{TransactionBacking, TransactionUpvote}, txTypes(txs))
+	txs = k.TransactionsByAddress(ctx, addr, FilterByTimeRange(start.Add(time.Hour), time.Time{}), SortOrder(SortDesc))
+	assert.Equal(t, []TransactionType{TransactionBackingReturned, TransactionUpvote, TransactionBacking}, txTypes(txs))
+
+	txs = k.TransactionsByAddress(ctx, addr, FilterByCommunityID("crypto"))
+	assert.Equal(t, []TransactionType{TransactionBacking, TransactionBackingReturned}, txTypes(txs))
+
+	txs = k.TransactionsByAddress(ctx, addr, FilterByReferenceID(3))
+	assert.Equal(t, []TransactionType{TransactionUpvote}, txTypes(txs))
+
+	txs = k.TransactionsByAddress(ctx, addr, FilterByFromModuleAccount(account.UserGrowthPoolName))
+	assert.Equal(t, []TransactionType{TransactionGift}, txTypes(txs))
+	assert.Len(t, k.TransactionsByAddress(ctx, addr, FilterByToModuleAccount(account.UserGrowthPoolName)), 0)
+}
+
+func TestKeeper_TransactionSummary(t *testing.T) {
+	ctx, k, auth := mockDB()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := createFakeFundedAccount(ctx, auth, sdk.NewCoins(app.NewShanevCoin(100)))
+
+	_, err := k.AddCoin(ctx.WithBlockTime(start), addr, app.NewShanevCoin(1), 1, TransactionStakeWinnings, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	_, err = k.AddCoin(ctx.WithBlockTime(start.Add(time.Hour)), addr, app.NewShanevCoin(2), 2, TransactionStakeWinnings, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	_, err = k.AddCoin(ctx.WithBlockTime(start.Add(time.Hour)), addr, app.NewShanevCoin(4), 3, TransactionStakeWinnings, exported.WithCommunityID("sports"))
+	assert.NoError(t, err)
+	_, err = k.AddCoin(ctx.WithBlockTime(start.Add(2*time.Hour)), addr, app.NewShanevCoin(8), 4, TransactionGift)
+	assert.NoError(t, err)
+
+	summaries := k.TransactionSummary(ctx, addr)
+	assert.Equal(t, []TransactionSummary{
+		{Type: TransactionGift, CommunityID: "", Count: 1, Amount: app.NewShanevCoin(8)},
+		{Type: TransactionStakeWinnings, CommunityID: "crypto", Count: 2, Amount: app.NewShanevCoin(3)},
+		{Type: TransactionStakeWinnings, CommunityID: "sports", Count: 1, Amount: app.NewShanevCoin(4)},
+	}, summaries)
+
+	summaries = k.TransactionSummary(ctx, addr, FilterByCommunityID("crypto"),
+		FilterByTimeRange(start.Add(time.Hour), start.Add(2*time.Hour)))
+	assert.Equal(t, []TransactionSummary{
+		{Type: TransactionStakeWinnings, CommunityID: "crypto", Count: 1, Amount: app.NewShanevCoin(2)},
+	}, summaries)
+}
+
+func TestKeeper_EarnedCoins(t *testing.T) {
+	ctx, k, auth := mockDB()
+	addr := createFakeFundedAccount(ctx, auth, sdk.NewCoins(app.NewShanevCoin(100)))
+	assert.True(t, k.EarnedCoins(ctx, addr).IsZero())
+
+	_, err := k.AddCoin(ctx, addr, app.NewShanevCoin(5), 1, TransactionStakeWinnings, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	_, err = k.SubtractCoin(ctx, addr, app.NewShanevCoin(2), 2, TransactionInterestUpvoteGivenSlashed, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	// gifts and stakes are not earnings
+	_, err = k.AddCoin(ctx, addr, app.NewShanevCoin(8), 0, TransactionGift)
+	assert.NoError(t, err)
+	_, err = k.SubtractCoin(ctx, addr, app.NewShanevCoin(10), 3, TransactionBacking, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, app.NewShanevCoin(3).Amount, k.EarnedCoins(ctx, addr))
+}
+
+func TestKeeper_TransactionIndexes(t *testing.T) {
+	ctx, k, auth := mockDB()
+	addr := createFakeFundedAccount(ctx, auth, sdk.NewCoins(app.NewShanevCoin(100)))
+	amount := app.NewShanevCoin(10)
+
+	_, err := k.SubtractCoin(ctx, addr, amount, 42, TransactionBacking, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+	_, err = k.SubtractCoin(ctx, addr, amount, 17, TransactionUpvote, exported.WithCommunityID("cryptotest"))
+	assert.NoError(t, err)
+	_, err = k.AddCoin(ctx, addr, amount, 42, TransactionBackingReturned, exported.WithCommunityID("crypto"))
+	assert.NoError(t, err)
+
+	txIDs := func(txs []Transaction) []uint64 {
+		ids := make([]uint64, 0)
+		for _, tx := range txs {
+			ids = append(ids, tx.ID)
+		}
+		return ids
+	}
+	indexed := func(prefix []byte) []uint64 {
+		page, err := k.transactionsPage(ctx, prefix, app.Pagination{})
+		assert.NoError(t, err)
+		return txIDs(page.Transactions)
+	}
+	assert.Equal(t, []uint64{1, 3}, indexed(referenceTransactionsPrefix(42)))
+	assert.Equal(t, []uint64{2}, indexed(referenceTransactionsPrefix(17)))
+	// a community id doesn't match the ids it prefixes
+	assert.Equal(t, []uint64{1, 3}, indexed(communityTransactionsPrefix("crypto")))
+	assert.Equal(t, []uint64{2}, indexed(typeTransactionsPrefix(TransactionUpvote)))
+	assert.Len(t, indexed(typeTransactionsPrefix(TransactionGift)), 0)
+
+	// transactions without a reference are left out of the reference index
+	_, err = k.AddCoin(ctx, addr, amount, 0, TransactionGift, FromModuleAccount(account.UserGrowthPoolName))
+	assert.NoError(t, err)
+	assert.Len(t, indexed(referenceTransactionsPrefix(0)), 0)
+
+	// a dangling index entry fails the query instead of panicking
+	k.store(ctx).Delete(transactionKey(2))
+	_, sdkErr := k.transactionsPage(ctx, referenceTransactionsPrefix(17), app.Pagination{})
+	assert.Equal(t, sdk.CodeInternal, sdkErr.Code())
+}
+
+func TestKeeper_PruneTransactions(t *testing.T) {
+	ctx, k, auth := mockDB()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := createFakeFundedAccount(ctx, auth, sdk.NewCoins(app.NewShanevCoin(100)))
+	params := k.GetParams(ctx)
+	params.TransactionRetention = 30 * 24 * time.Hour
+	params.PruneBatchSize = 2
+	k.SetParams(ctx, params)
+
+	for i := 0; i < 3; i++ {
+		_, err := k.AddCoin(ctx.WithBlockTime(start.Add(time.Duration(i)*time.Hour)), addr, app.NewShanevCoin(1),
+			7, TransactionStakeWinnings, exported.WithCommunityID("crypto"))
+		assert.NoError(t, err)
+	}
+	_, err := k.AddCoin(ctx.WithBlockTime(start.Add(40*24*time.Hour)), addr, app.NewShanevCoin(8), 0, TransactionGift)
+	assert.NoError(t, err)
+	lifetime := k.TransactionSummary(ctx, addr)
+	earned := k.EarnedCoins(ctx, addr)
+
+	// nothing is past retention yet
+	EndBlocker(ctx.WithBlockTime(start.Add(24*time.Hour)), k)
+	assert.Len(t, k.Transactions(ctx), 4)
+
+	// batches are bounded by PruneBatchSize
+	EndBlocker(ctx.WithBlockTime(start.Add(45*24*time.Hour)), k)
+	assert.Len(t, k.Transactions(ctx), 2)
+	EndBlocker(ctx.WithBlockTime(start.Add(45*24*time.Hour)), k)
+	assert.Len(t, k.Transactions(ctx), 1)
+	// pruned transactions are dropped from the indexes
+	for _, prefix := range [][]byte{referenceTransactionsPrefix(7), communityTransactionsPrefix("crypto")} {
+		page, sdkErr := k.transactionsPage(ctx, prefix, app.Pagination{})
+		assert.NoError(t, sdkErr)
+		assert.Len(t, page.Transactions, 0)
+	}
+	assert.Len(t, k.TransactionsByAddress(ctx, addr, FilterByTransactionType(TransactionStakeWinnings)), 0)
+
+	assert.Equal(t, []TransactionRollup{
+		{Address: addr, Type: TransactionStakeWinnings, CommunityID: "crypto", Count: 3, Amount: app.NewShanevCoin(3)},
+	}, k.Rollups(ctx))
+	// lifetime totals survive pruning, ranged ones only cover what is left
+	assert.Equal(t, lifetime, k.TransactionSummary(ctx, addr))
+	assert.Equal(t, earned, k.EarnedCoins(ctx, addr))
+	assert.Len(t, k.TransactionSummary(ctx, addr, FilterByTimeRange(start, start.Add(24*time.Hour))), 0)
+
+	// ids keep growing after pruning
+	_, err = k.AddCoin(ctx, addr, app.NewShanevCoin(1), 0, TransactionTip)
+	assert.NoError(t, err)
+	tx, ok := k.getTransaction(ctx, 5)
+	assert.True(t, ok)
+	assert.Equal(t, TransactionTip, tx.Type)
+}
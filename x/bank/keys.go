@@ -14,7 +14,19 @@ var (
 	TransactionIDKey = []byte{0x10}
 
 	// AssociationKeys
-	UserTransactionKeyPrefix = []byte{0x20}
+	UserTransactionKeyPrefix      = []byte{0x20}
+	ReferenceTransactionKeyPrefix = []byte{0x30}
+	CommunityTransactionKeyPrefix = []byte{0x40}
+	TypeTransactionKeyPrefix      = []byte{0x50}
+
+	// Rollups of pruned transactions
+	UserRollupKeyPrefix = []byte{0x60}
+
+	// Gift batches the reward broker sent today
+	DailyGiftsKey = []byte{0x70}
+
+	// Running earned totals of users
+	UserEarnedKeyPrefix = []byte{0x80}
 )
 
 // stakeKey gets a key for a stake.
@@ -37,3 +49,57 @@ func userTransactionKey(creator sdk.AccAddress, createdTime time.Time, transacti
 	timeBz := sdk.FormatTimeBytes(createdTime)
 	return append(userTransactionsPrefix(creator), append(timeBz, bz...)...)
 }
+
+// referenceTransactionsPrefix
+// 0x30<reference_id>
+func referenceTransactionsPrefix(referenceID uint64) []byte {
+	return append(ReferenceTransactionKeyPrefix, sdk.Uint64ToBigEndian(referenceID)...)
+}
+
+// referenceTransactionKey builds the key for reference->transaction association
+// 0x30<reference_id><transaction_id>
+func referenceTransactionKey(referenceID, transactionID uint64) []byte {
+	return append(referenceTransactionsPrefix(referenceID), sdk.Uint64ToBigEndian(transactionID)...)
+}
+
+// communityTransactionsPrefix is length prefixed so one community id can't prefix another
+// 0x40<community_id_length><community_id>
+func communityTransactionsPrefix(communityID string) []byte {
+	return append(append(CommunityTransactionKeyPrefix, byte(len(communityID))), []byte(communityID)...)
+}
+
+// communityTransactionKey builds the key for community->transaction association
+// 0x40<community_id_length><community_id><transaction_id>
+func communityTransactionKey(communityID string, transactionID uint64) []byte {
+	return append(communityTransactionsPrefix(communityID), sdk.Uint64ToBigEndian(transactionID)...)
+}
+
+// typeTransactionsPrefix
+// 0x50<type>
+func typeTransactionsPrefix(txType TransactionType) []byte {
+	return append(TypeTransactionKeyPrefix, byte(txType))
+}
+
+// typeTransactionKey builds the key for type->transaction association
+// 0x50<type><transaction_id>
+func typeTransactionKey(txType TransactionType, transactionID uint64) []byte {
+	return append(typeTransactionsPrefix(txType), sdk.Uint64ToBigEndian(transactionID)...)
+}
+
+// userRollupsPrefix
+// 0x60<address>
+func userRollupsPrefix(address sdk.AccAddress) []byte {
+	return append(UserRollupKeyPrefix, address.Bytes()...)
+}
+
+// userRollupKey builds the key for the rollup of a user's pruned transactions
+// 0x60<address><type><community_id>
+func userRollupKey(address sdk.AccAddress, txType TransactionType, communityID string) []byte {
+	return append(append(userRollupsPrefix(address), byte(txType)), []byte(communityID)...)
+}
+
+// userEarnedKey builds the key for the running earned total of a user
+// 0x80<address>
+func userEarnedKey(address sdk.AccAddress) []byte {
+	return append(UserEarnedKeyPrefix, address.Bytes()...)
+}
@@ -1,16 +1,25 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	TypeMsgSendGift     = "send_gift"
-	TypeMsgUpdateParams = "update_params"
+	TypeMsgSendGift      = "send_gift"
+	TypeMsgUpdateParams  = "update_params"
+	TypeMsgTip           = "tip"
+	TypeMsgSendGiftBatch = "send_gift_batch"
+
+	// MaxCampaignIDLength is the longest campaign id of a gift batch
+	MaxCampaignIDLength = 64
 )
 
 var (
 	_ sdk.Msg = &MsgSendGift{}
+	_ sdk.Msg = &MsgTip{}
+	_ sdk.Msg = &MsgSendGiftBatch{}
 )
 
 type MsgSendGift struct {
@@ -92,3 +101,118 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgTip defines the message to tip a user or the creator of an argument
+type MsgTip struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient,omitempty"`
+	ArgumentID uint64         `json:"argument_id,omitempty"`
+	Amount     sdk.Coin       `json:"amount"`
+}
+
+// NewMsgTip returns the message to tip a recipient, or the creator of an argument when argumentID is set
+func NewMsgTip(sender, recipient sdk.AccAddress, argumentID uint64, amount sdk.Coin) MsgTip {
+	return MsgTip{
+		Sender:     sender,
+		Recipient:  recipient,
+		ArgumentID: argumentID,
+		Amount:     amount,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgTip) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if (len(msg.Recipient) == 0) == (msg.ArgumentID == 0) {
+		return ErrInvalidTip("either a recipient or an argument must be set")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins("invalid coins")
+	}
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgTip) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgTip) Type() string { return TypeMsgTip }
+
+// GetSignBytes implements Msg
+func (msg MsgTip) GetSignBytes() []byte {
+	bz := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg. Returns the sender as the signer.
+func (msg MsgTip) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Gift is a recipient of a gift batch and the coins it gets
+type Gift struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coin       `json:"amount"`
+}
+
+// MsgSendGiftBatch defines the message for the reward broker to gift many users at once
+type MsgSendGiftBatch struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	Gifts      []Gift         `json:"gifts"`
+	CampaignID string         `json:"campaign_id,omitempty"`
+}
+
+// NewMsgSendGiftBatch returns the message to send a batch of gifts for a campaign
+func NewMsgSendGiftBatch(sender sdk.AccAddress, gifts []Gift, campaignID string) MsgSendGiftBatch {
+	return MsgSendGiftBatch{
+		Sender:     sender,
+		Gifts:      gifts,
+		CampaignID: campaignID,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSendGiftBatch) ValidateBasic() sdk.Error {
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if len(msg.Gifts) == 0 {
+		return ErrInvalidGiftBatch("no gifts")
+	}
+	if len(msg.CampaignID) > MaxCampaignIDLength {
+		return ErrInvalidGiftBatch(fmt.Sprintf("campaign id must be under %d chars", MaxCampaignIDLength))
+	}
+	recipients := make(map[string]bool, len(msg.Gifts))
+	for _, gift := range msg.Gifts {
+		if len(gift.Recipient) == 0 {
+			return sdk.ErrInvalidAddress("invalid recipient address")
+		}
+		if !gift.Amount.IsValid() || !gift.Amount.IsPositive() {
+			return sdk.ErrInvalidCoins("invalid coins")
+		}
+		if recipients[gift.Recipient.String()] {
+			return ErrInvalidGiftBatch(fmt.Sprintf("duplicate recipient %s", gift.Recipient))
+		}
+		recipients[gift.Recipient.String()] = true
+	}
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSendGiftBatch) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSendGiftBatch) Type() string { return TypeMsgSendGiftBatch }
+
+// GetSignBytes implements Msg
+func (msg MsgSendGiftBatch) GetSignBytes() []byte {
+	bz := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg. Returns the sender as the signer.
+func (msg MsgSendGiftBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
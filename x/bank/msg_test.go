@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,3 +44,51 @@ func TestMsgSendGift_ValidateBasic(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, sdk.CodeInvalidCoins, err.Code())
 }
+
+func TestMsgTip_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("from"))
+	recipient := sdk.AccAddress([]byte("to"))
+	amount := sdk.NewInt64Coin("mydenom", 10)
+	msg := NewMsgTip(sender, recipient, 0, amount)
+	assert.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, "tip", msg.Type())
+	assert.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+
+	assert.NoError(t, NewMsgTip(sender, nil, 1, amount).ValidateBasic())
+
+	err := NewMsgTip(sender, recipient, 1, amount).ValidateBasic()
+	assert.Equal(t, ErrorCodeInvalidTip, err.Code())
+
+	err = NewMsgTip(sender, nil, 0, amount).ValidateBasic()
+	assert.Equal(t, ErrorCodeInvalidTip, err.Code())
+
+	err = NewMsgTip(sender, recipient, 0, sdk.NewInt64Coin("mydenom", 0)).ValidateBasic()
+	assert.Equal(t, sdk.CodeInvalidCoins, err.Code())
+}
+
+func TestMsgSendGiftBatch_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("from"))
+	gifts := []Gift{
+		{Recipient: sdk.AccAddress([]byte("to")), Amount: sdk.NewInt64Coin("mydenom", 10)},
+		{Recipient: sdk.AccAddress([]byte("other")), Amount: sdk.NewInt64Coin("mydenom", 5)},
+	}
+	msg := NewMsgSendGiftBatch(sender, gifts, "launch")
+	assert.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, "send_gift_batch", msg.Type())
+	assert.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+
+	err := NewMsgSendGiftBatch(nil, gifts, "").ValidateBasic()
+	assert.Equal(t, sdk.CodeInvalidAddress, err.Code())
+
+	err = NewMsgSendGiftBatch(sender, []Gift{}, "").ValidateBasic()
+	assert.Equal(t, ErrorCodeInvalidGiftBatch, err.Code())
+
+	err = NewMsgSendGiftBatch(sender, gifts, strings.Repeat("a", MaxCampaignIDLength+1)).ValidateBasic()
+	assert.Equal(t, ErrorCodeInvalidGiftBatch, err.Code())
+
+	err = NewMsgSendGiftBatch(sender, []Gift{gifts[0], gifts[0]}, "").ValidateBasic()
+	assert.Equal(t, ErrorCodeInvalidGiftBatch, err.Code())
+
+	err = NewMsgSendGiftBatch(sender, []Gift{{Recipient: gifts[0].Recipient, Amount: sdk.NewInt64Coin("mydenom", 0)}}, "").ValidateBasic()
+	assert.Equal(t, sdk.CodeInvalidCoins, err.Code())
+}
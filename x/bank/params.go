@@ -2,26 +2,60 @@ package bank
 
 import (
 	"reflect"
+	"time"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 var (
-	ParamKeyRewardBrokerAddress = []byte("rewardBrokerAddress")
+	ParamKeyRewardBrokerAddress  = []byte("rewardBrokerAddress")
+	ParamKeyDailyTipLimit        = []byte("dailyTipLimit")
+	ParamKeyTransactionRetention = []byte("transactionRetention")
+	ParamKeyPruneBatchSize       = []byte("pruneBatchSize")
+	ParamKeyMaxGiftBatchSize     = []byte("maxGiftBatchSize")
+	ParamKeyGiftBatchLimit       = []byte("giftBatchLimit")
+	ParamKeyDailyGiftLimit       = []byte("dailyGiftLimit")
 )
 
 type Params struct {
 	RewardBrokerAddress sdk.AccAddress `json:"reward_broker_address"`
+	// DailyTipLimit is the most a user can tip in a day
+	DailyTipLimit sdk.Coin `json:"daily_tip_limit"`
+	// TransactionRetention is how long transactions are kept in state, zero keeps them forever
+	TransactionRetention time.Duration `json:"transaction_retention"`
+	// PruneBatchSize is the most transactions pruned in a block
+	PruneBatchSize int `json:"prune_batch_size"`
+	// MaxGiftBatchSize is the most recipients of a gift batch
+	MaxGiftBatchSize int `json:"max_gift_batch_size"`
+	// GiftBatchLimit is the most a gift batch can send
+	GiftBatchLimit sdk.Coin `json:"gift_batch_limit"`
+	// DailyGiftLimit is the most the reward broker can gift in batches in a day
+	DailyGiftLimit sdk.Coin `json:"daily_gift_limit"`
 }
 
 func DefaultParams() Params {
-	return Params{RewardBrokerAddress: nil}
+	return Params{
+		RewardBrokerAddress:  nil,
+		DailyTipLimit:        app.NewShanevCoin(100),
+		TransactionRetention: 0,
+		PruneBatchSize:       100,
+		MaxGiftBatchSize:     500,
+		GiftBatchLimit:       app.NewShanevCoin(10000),
+		DailyGiftLimit:       app.NewShanevCoin(50000),
+	}
 }
 
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: ParamKeyRewardBrokerAddress, Value: &p.RewardBrokerAddress},
+		{Key: ParamKeyDailyTipLimit, Value: &p.DailyTipLimit},
+		{Key: ParamKeyTransactionRetention, Value: &p.TransactionRetention},
+		{Key: ParamKeyPruneBatchSize, Value: &p.PruneBatchSize},
+		{Key: ParamKeyMaxGiftBatchSize, Value: &p.MaxGiftBatchSize},
+		{Key: ParamKeyGiftBatchLimit, Value: &p.GiftBatchLimit},
+		{Key: ParamKeyDailyGiftLimit, Value: &p.DailyGiftLimit},
 	}
 }
 
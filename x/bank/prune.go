@@ -0,0 +1,111 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pruneTransactions drops up to PruneBatchSize transactions older than TransactionRetention,
+// oldest first, and adds them to the rollups of their users
+func (k Keeper) pruneTransactions(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.TransactionRetention <= 0 || params.PruneBatchSize <= 0 {
+		return
+	}
+	cutoff := ctx.BlockHeader().Time.Add(-params.TransactionRetention)
+
+	// transaction ids grow with block time, so the oldest transactions come first
+	pruned := make([]Transaction, 0)
+	k.IterateTransactions(ctx, func(tx Transaction) bool {
+		if !tx.CreatedTime.Before(cutoff) {
+			return true
+		}
+		pruned = append(pruned, tx)
+		return len(pruned) == params.PruneBatchSize
+	})
+	if len(pruned) == 0 {
+		return
+	}
+	for _, tx := range pruned {
+		k.pruneTransaction(ctx, tx)
+	}
+
+	lastID := pruned[len(pruned)-1].ID
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeTransactionsPruned,
+			sdk.NewAttribute(AttributeKeyCount, fmt.Sprintf("%d", len(pruned))),
+			sdk.NewAttribute(AttributeKeyLastTransactionID, fmt.Sprintf("%d", lastID)),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Pruned %d transactions up to %d", len(pruned), lastID))
+}
+
+// pruneTransaction removes a transaction and its indexes from state
+func (k Keeper) pruneTransaction(ctx sdk.Context, tx Transaction) {
+	store := k.store(ctx)
+	store.Delete(transactionKey(tx.ID))
+	store.Delete(userTransactionKey(tx.AppAccountAddress, tx.CreatedTime, tx.ID))
+	if tx.ReferenceID != 0 {
+		store.Delete(referenceTransactionKey(tx.ReferenceID, tx.ID))
+	}
+	if tx.CommunityID != "" {
+		store.Delete(communityTransactionKey(tx.CommunityID, tx.ID))
+	}
+	store.Delete(typeTransactionKey(tx.Type, tx.ID))
+
+	rollup, ok := k.userRollup(ctx, tx.AppAccountAddress, tx.Type, tx.CommunityID)
+	if !ok {
+		rollup = TransactionRollup{
+			Address:     tx.AppAccountAddress,
+			Type:        tx.Type,
+			CommunityID: tx.CommunityID,
+			Amount:      sdk.NewCoin(tx.Amount.Denom, sdk.ZeroInt()),
+		}
+	}
+	rollup.Count++
+	rollup.Amount = rollup.Amount.Add(tx.Amount)
+	k.setUserRollup(ctx, rollup)
+}
+
+// IterateUserRollups iterates over the rollups of the pruned transactions of a user
+func (k Keeper) IterateUserRollups(ctx sdk.Context, address sdk.AccAddress, cb func(rollup TransactionRollup) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userRollupsPrefix(address))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rollup TransactionRollup
+		k.codec.MustUnmarshalBinaryBare(iterator.Value(), &rollup)
+		if cb(rollup) {
+			break
+		}
+	}
+}
+
+// Rollups returns the rollups of all users
+func (k Keeper) Rollups(ctx sdk.Context) []TransactionRollup {
+	rollups := make([]TransactionRollup, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), UserRollupKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rollup TransactionRollup
+		k.codec.MustUnmarshalBinaryBare(iterator.Value(), &rollup)
+		rollups = append(rollups, rollup)
+	}
+	return rollups
+}
+
+func (k Keeper) userRollup(ctx sdk.Context, address sdk.AccAddress, txType TransactionType, communityID string) (TransactionRollup, bool) {
+	var rollup TransactionRollup
+	bz := k.store(ctx).Get(userRollupKey(address, txType, communityID))
+	if bz == nil {
+		return rollup, false
+	}
+	k.codec.MustUnmarshalBinaryBare(bz, &rollup)
+	return rollup, true
+}
+
+func (k Keeper) setUserRollup(ctx sdk.Context, rollup TransactionRollup) {
+	bz := k.codec.MustMarshalBinaryBare(rollup)
+	k.store(ctx).Set(userRollupKey(rollup.Address, rollup.Type, rollup.CommunityID), bz)
+}
@@ -1,6 +1,7 @@
 package bank
 
 import (
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -11,6 +12,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryTransactionsByAddress:
 			return queryTransactionsByAddress(ctx, req, keeper)
+		case QueryTransactionSummary:
+			return queryTransactionSummary(ctx, req, keeper)
+		case QueryTransactionsByReference:
+			return queryTransactionsByReference(ctx, req, keeper)
+		case QueryTransactionsByCommunity:
+			return queryTransactionsByCommunity(ctx, req, keeper)
+		case QueryTransactionsByType:
+			return queryTransactionsByType(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
 		default:
@@ -29,16 +38,74 @@ func queryTransactionsByAddress(ctx sdk.Context, req abci.RequestQuery, keeper K
 	if params.SortOrder.Valid() {
 		sortOrder = params.SortOrder
 	}
-	transactions := keeper.TransactionsByAddress(ctx,
-		params.Address,
+	filters := []Filter{
 		FilterByTransactionType(params.Types...),
 		SortOrder(sortOrder),
 		Limit(params.Limit),
 		Offset(params.Offset),
-	)
+		FilterByTimeRange(params.StartTime, params.EndTime),
+		FilterByCommunityID(params.CommunityID),
+		FilterByFromModuleAccount(params.FromModuleAccount),
+		FilterByToModuleAccount(params.ToModuleAccount),
+		FilterByMemo(params.Memo),
+	}
+	if params.ReferenceID != nil {
+		filters = append(filters, FilterByReferenceID(*params.ReferenceID))
+	}
+	transactions := keeper.TransactionsByAddress(ctx, params.Address, filters...)
 	return keeper.codec.MustMarshalJSON(transactions), nil
 }
 
+func queryTransactionSummary(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTransactionSummaryParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	summaries := keeper.TransactionSummary(ctx,
+		params.Address,
+		FilterByTransactionType(params.Types...),
+		FilterByTimeRange(params.StartTime, params.EndTime),
+		FilterByCommunityID(params.CommunityID),
+	)
+	return keeper.codec.MustMarshalJSON(summaries), nil
+}
+
+func queryTransactionsByReference(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTransactionsByReferenceParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	return queryTransactionsPage(ctx, keeper, referenceTransactionsPrefix(params.ReferenceID), params.Pagination)
+}
+
+func queryTransactionsByCommunity(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTransactionsByCommunityParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	return queryTransactionsPage(ctx, keeper, communityTransactionsPrefix(params.CommunityID), params.Pagination)
+}
+
+func queryTransactionsByType(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTransactionsByTypeParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	return queryTransactionsPage(ctx, keeper, typeTransactionsPrefix(params.Type), params.Pagination)
+}
+
+func queryTransactionsPage(ctx sdk.Context, keeper Keeper, prefix []byte, pagination app.Pagination) ([]byte, sdk.Error) {
+	page, err := keeper.transactionsPage(ctx, prefix, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return keeper.codec.MustMarshalJSON(page), nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
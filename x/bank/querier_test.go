@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -87,3 +88,61 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryTransactionSummary(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+	_, _, address := keyPubAddr()
+	transactions := []Transaction{
+		{ID: 1, Type: TransactionStakeWinnings, AppAccountAddress: address, CommunityID: "crypto",
+			Amount: sdk.NewInt64Coin("mydenom", 10), CreatedTime: ctx.BlockHeader().Time},
+		{ID: 2, Type: TransactionStakeWinnings, AppAccountAddress: address, CommunityID: "crypto",
+			Amount: sdk.NewInt64Coin("mydenom", 5), CreatedTime: ctx.BlockHeader().Time},
+	}
+	InitGenesis(ctx, keeper, NewGenesisState(DefaultParams(), transactions))
+
+	querier := NewQuerier(keeper)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryTransactionSummary}, "/"),
+		Data: keeper.codec.MustMarshalJSON(QueryTransactionSummaryParams{Address: address, CommunityID: "crypto"}),
+	}
+	bz, err := querier(ctx, []string{QueryTransactionSummary}, query)
+	assert.NoError(t, err)
+	var summaries []TransactionSummary
+	assert.NoError(t, keeper.codec.UnmarshalJSON(bz, &summaries))
+	assert.Equal(t, []TransactionSummary{
+		{Type: TransactionStakeWinnings, CommunityID: "crypto", Count: 2, Amount: sdk.NewInt64Coin("mydenom", 15)},
+	}, summaries)
+}
+
+func TestQueryTransactionsByReference_Paginated(t *testing.T) {
+	ctx, keeper, _ := mockDB()
+	_, _, address := keyPubAddr()
+	transactions := make([]Transaction, 0)
+	for id := uint64(1); id <= 3; id++ {
+		transactions = append(transactions, Transaction{ID: id, Type: TransactionBacking, AppAccountAddress: address,
+			ReferenceID: 42, Amount: sdk.NewInt64Coin("mydenom", 10), CreatedTime: ctx.BlockHeader().Time})
+	}
+	InitGenesis(ctx, keeper, NewGenesisState(DefaultParams(), transactions))
+
+	querier := NewQuerier(keeper)
+	queryParams := QueryTransactionsByReferenceParams{ReferenceID: 42, Pagination: app.Pagination{Limit: 2}}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryTransactionsByReference}, "/"),
+		Data: keeper.codec.MustMarshalJSON(queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryTransactionsByReference}, query)
+	assert.NoError(t, err)
+	var page TransactionsPage
+	assert.NoError(t, keeper.codec.UnmarshalJSON(bz, &page))
+	assert.Equal(t, transactions[:2], page.Transactions)
+	assert.NotEmpty(t, page.NextCursor)
+
+	queryParams.Pagination.Cursor = page.NextCursor
+	query.Data = keeper.codec.MustMarshalJSON(queryParams)
+	bz, err = querier(ctx, []string{QueryTransactionsByReference}, query)
+	assert.NoError(t, err)
+	page = TransactionsPage{}
+	assert.NoError(t, keeper.codec.UnmarshalJSON(bz, &page))
+	assert.Equal(t, transactions[2:], page.Transactions)
+	assert.Empty(t, page.NextCursor)
+}
@@ -0,0 +1,85 @@
+package bank
+
+import (
+	"time"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tip moves coins from a user to another user, or to the creator of an argument.
+// Both sides are resolved to the identity of their AppAccount. Jailed users can
+// neither send nor receive tips, and a user can tip up to DailyTipLimit a day.
+// Both sides get a transaction referencing the argument and its community.
+func (k Keeper) Tip(ctx sdk.Context, sender, recipient sdk.AccAddress,
+	argumentID uint64, amount sdk.Coin) (sent Transaction, received Transaction, err sdk.Error) {
+	if amount.Denom != app.StakeDenom {
+		return sent, received, sdk.ErrInvalidCoins("Invalid denomination coin")
+	}
+	communityID := ""
+	if argumentID != 0 {
+		creator, argumentCommunityID, ok := k.stakingKeeper.ArgumentCreator(ctx, argumentID)
+		if !ok {
+			return sent, received, ErrUnknownArgument(argumentID)
+		}
+		recipient, communityID = creator, argumentCommunityID
+	}
+	sender, err = k.accountKeeper.Identity(ctx, sender)
+	if err != nil {
+		return
+	}
+	recipient, err = k.accountKeeper.Identity(ctx, recipient)
+	if err != nil {
+		return
+	}
+	if sender.Equals(recipient) {
+		return sent, received, ErrInvalidTip("cannot tip yourself")
+	}
+	for _, address := range []sdk.AccAddress{sender, recipient} {
+		jailed, err := k.accountKeeper.IsJailed(ctx, address)
+		if err != nil {
+			return sent, received, err
+		}
+		if jailed {
+			return sent, received, ErrJailed(address)
+		}
+	}
+	limit := k.GetParams(ctx).DailyTipLimit
+	if k.tippedToday(ctx, sender).Add(amount.Amount).GT(limit.Amount) {
+		return sent, received, ErrDailyTipLimitReached(limit)
+	}
+
+	// the two transactions are recorded under the next two IDs
+	sentID, err := k.transactionID(ctx)
+	if err != nil {
+		return
+	}
+	_, err = k.SubtractCoin(ctx, sender, amount, argumentID, TransactionTipSent, WithCommunityID(communityID))
+	if err != nil {
+		return
+	}
+	_, err = k.AddCoin(ctx, recipient, amount, argumentID, TransactionTip, WithCommunityID(communityID))
+	if err != nil {
+		return
+	}
+	sent, _ = k.getTransaction(ctx, sentID)
+	received, _ = k.getTransaction(ctx, sentID+1)
+
+	return sent, received, nil
+}
+
+// tippedToday sums the tips a user sent since the start of the UTC day
+func (k Keeper) tippedToday(ctx sdk.Context, address sdk.AccAddress) sdk.Int {
+	dayStart := ctx.BlockHeader().Time.Truncate(24 * time.Hour)
+	tipped := sdk.ZeroInt()
+	k.IterateUserTransactions(ctx, address, true, func(tx Transaction) bool {
+		if tx.CreatedTime.Before(dayStart) {
+			return true
+		}
+		if tx.Type == TransactionTipSent {
+			tipped = tipped.Add(tx.Amount.Amount)
+		}
+		return false
+	})
+	return tipped
+}
@@ -15,4 +15,12 @@ const (
 	DefaultParamspace = ModuleName
 
 	AttributeRecipient = "recipient"
+
+	EventTypeTransactionsPruned   = "transactions_pruned"
+	AttributeKeyCount             = "count"
+	AttributeKeyLastTransactionID = "last_transaction_id"
+
+	EventTypeGiftBatchSent = "gift_batch_sent"
+	AttributeKeyCampaignID = "campaign_id"
+	AttributeKeyAmount     = "amount"
 )
@@ -0,0 +1,37 @@
+package claim
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, closes voting on claims
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.closeVoting(ctx)
+}
+
+func (k Keeper) closeVoting(ctx sdk.Context) {
+	claims := k.iterateAssociated(ctx, k.votingClaimQueueIterator(ctx, ctx.BlockHeader().Time))
+
+	for _, claim := range claims {
+		k.store(ctx).Delete(votingClaimQueueKey(claim.VotingEndTime, claim.ID))
+		if claim.Status != ClaimOpen {
+			continue
+		}
+		claim.Status = ClaimVotingClosed
+		claim.Verdict = k.verdict(ctx, claim)
+		k.setClaim(ctx, claim)
+		k.setVotingClosedClaim(ctx, claim.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeClaimVotingClosed,
+				sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", claim.ID)),
+				sdk.NewAttribute(AttributeKeyVerdict, fmt.Sprintf("%d", claim.Verdict)),
+			),
+		)
+
+		logger(ctx).Info(fmt.Sprintf("Closed voting on claim %d", claim.ID))
+	}
+}
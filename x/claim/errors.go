@@ -23,6 +23,10 @@ const (
 	ErrorCodeCreatorJailed               CodeType = 108
 	ErrorCodeAddressNotAuthorised        CodeType = 109
 	ErrorCodeJSONParsing                 CodeType = 110
+	ErrorCodeInvalidClaimStatus          CodeType = 111
+	ErrorCodeInvalidQueryParams          CodeType = 112
+	ErrorCodeCreatorDeactivated          CodeType = 113
+	ErrorCodeInvalidParams               CodeType = 114
 )
 
 // ErrInvalidBodyTooShort throws an error on invalid claim body
@@ -73,6 +77,14 @@ func ErrCreatorJailed(addr sdk.AccAddress) sdk.Error {
 		"Creator cannot be jailed: "+addr.String())
 }
 
+// ErrCreatorDeactivated throws an error on deactivated creator
+func ErrCreatorDeactivated(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeCreatorDeactivated,
+		"Creator cannot be deactivated: "+addr.String())
+}
+
 // ErrAddressNotAuthorised throws an error when the address is not admin
 func ErrAddressNotAuthorised() sdk.Error {
 	return sdk.NewError(
@@ -88,3 +100,27 @@ func ErrJSONParse(err error) sdk.Error {
 		ErrorCodeJSONParsing,
 		"JSON parsing error: "+err.Error())
 }
+
+// ErrInvalidClaimStatus throws an error when a claim is not in the expected lifecycle state
+func ErrInvalidClaimStatus(id uint64, status ClaimStatus) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidClaimStatus,
+		fmt.Sprintf("Invalid status %s for claim id: %d", status.String(), id))
+}
+
+// ErrInvalidQueryParams throws an error when query params such as pagination are invalid
+func ErrInvalidQueryParams(err error) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidQueryParams,
+		"Invalid query params: "+err.Error())
+}
+
+// ErrInvalidParams throws an error when updated params are invalid
+func ErrInvalidParams(err error) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidParams,
+		"Invalid params: "+err.Error())
+}
@@ -7,4 +7,5 @@ import (
 // AccountKeeper is the expected account keeper interface for this module
 type AccountKeeper interface {
 	IsJailed(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Error)
+	IsDeactivated(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Error)
 }
@@ -1,7 +1,7 @@
 package claim
 
 import (
-	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,14 +25,37 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 
 // InitGenesis initializes story state from genesis file
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	params := withDefaultParams(data.Params)
 	for _, c := range data.Claims {
+		// claims argued before voting existed start voting from their first argument
+		if c.Status == ClaimOpen && c.VotingEndTime.Equal(time.Time{}) && !c.FirstArgumentTime.Equal(time.Time{}) {
+			c.VotingEndTime = c.FirstArgumentTime.Add(params.VotingPeriod)
+		}
 		k.setClaim(ctx, c)
 		k.setCommunityClaim(ctx, c.CommunityID, c.ID)
 		k.setCreatorClaim(ctx, c.Creator, c.ID)
 		k.setCreatedTimeClaim(ctx, c.CreatedTime, c.ID)
+		switch {
+		case c.Status == ClaimOpen && !c.VotingEndTime.Equal(time.Time{}):
+			k.insertVotingClaimQueue(ctx, c.ID, c.VotingEndTime)
+		case c.Status == ClaimVotingClosed:
+			k.setVotingClosedClaim(ctx, c.ID)
+		}
 	}
 	k.setClaimID(ctx, uint64(len(data.Claims)+1))
-	k.SetParams(ctx, data.Params)
+	k.SetParams(ctx, params)
+}
+
+// withDefaultParams fills the params missing from a genesis exported before they existed
+func withDefaultParams(p Params) Params {
+	defaults := DefaultParams()
+	if p.VotingPeriod == 0 {
+		p.VotingPeriod = defaults.VotingPeriod
+	}
+	if p.MajorityPercent.IsNil() {
+		p.MajorityPercent = defaults.MajorityPercent
+	}
+	return p
 }
 
 // ExportGenesis exports the genesis state
@@ -45,12 +68,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
-	if data.Params.MinClaimLength < 1 {
-		return fmt.Errorf("Param: MinClaimLength must have a positive value")
-	}
-	if data.Params.MaxClaimLength < 1 {
-		return fmt.Errorf("Param: MaxClaimLength must have a positive value")
-	}
-
-	return nil
+	data.Params = withDefaultParams(data.Params)
+	return validateParams(data.Params)
 }
@@ -0,0 +1,63 @@
+package claim
+
+import (
+	"testing"
+	"time"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// genesis exported before claims had a voting lifecycle
+const legacyGenesis = `{
+	"claims": [],
+	"params": {
+		"min_claim_length": 25,
+		"max_claim_length": 140,
+		"claim_admins": []
+	}
+}`
+
+func TestGenesis_LegacyParams(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	var state GenesisState
+	ModuleCodec.MustUnmarshalJSON([]byte(legacyGenesis), &state)
+	assert.NoError(t, ValidateGenesis(state))
+
+	InitGenesis(ctx, keeper, state)
+	params := keeper.GetParams(ctx)
+	assert.Equal(t, DefaultParams().VotingPeriod, params.VotingPeriod)
+	assert.True(t, DefaultParams().MajorityPercent.Equal(params.MajorityPercent))
+	assert.Equal(t, 140, params.MaxClaimLength)
+}
+
+func TestGenesis_LegacyOpenClaim(t *testing.T) {
+	ctx, keeper := mockDB()
+	firstArgumentTime := time.Now().UTC()
+	claim := Claim{
+		ID:                1,
+		CommunityID:       "crypto",
+		Body:              "Preethi can handle liquor better than Aamir.",
+		Creator:           sdk.AccAddress([]byte{1, 2}),
+		TotalBacked:       sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged:   sdk.NewInt64Coin(app.StakeDenom, 0),
+		CreatedTime:       firstArgumentTime,
+		FirstArgumentTime: firstArgumentTime,
+		Status:            ClaimOpen,
+	}
+	state := GenesisState{Claims: Claims{claim}, Params: DefaultParams()}
+	assert.NoError(t, ValidateGenesis(state))
+
+	InitGenesis(ctx, keeper, state)
+	c, ok := keeper.Claim(ctx, claim.ID)
+	assert.True(t, ok)
+	votingEndTime := firstArgumentTime.Add(DefaultParams().VotingPeriod)
+	assert.True(t, votingEndTime.Equal(c.VotingEndTime))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: votingEndTime})
+	EndBlocker(ctx, keeper)
+	assert.Len(t, keeper.VotingClosedClaims(ctx), 1)
+}
@@ -49,6 +49,13 @@ func (k Keeper) SubmitClaim(ctx sdk.Context, body, communityID string,
 	if jailed {
 		return claim, ErrCreatorJailed(creator)
 	}
+	deactivated, err := k.accountKeeper.IsDeactivated(ctx, creator)
+	if err != nil {
+		return
+	}
+	if deactivated {
+		return claim, ErrCreatorDeactivated(creator)
+	}
 	community, err := k.communityKeeper.Community(ctx, communityID)
 	if err != nil {
 		return claim, ErrInvalidCommunityID(community.ID)
@@ -216,11 +223,78 @@ func (k Keeper) SetFirstArgumentTime(ctx sdk.Context, id uint64, firstArgumentTi
 		return ErrUnknownClaim(id)
 	}
 	claim.FirstArgumentTime = firstArgumentTime
+	// voting starts with the first argument
+	if claim.Status == ClaimOpen && claim.VotingEndTime.Equal(time.Time{}) {
+		claim.VotingEndTime = firstArgumentTime.Add(k.GetParams(ctx).VotingPeriod)
+		k.insertVotingClaimQueue(ctx, claim.ID, claim.VotingEndTime)
+	}
 	k.setClaim(ctx, claim)
 
 	return nil
 }
 
+// VotingClosedClaims gets all claims that have closed voting and are waiting to be resolved
+func (k Keeper) VotingClosedClaims(ctx sdk.Context) (claims Claims) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, VotingClosedClaimsPrefix)
+
+	return k.iterateAssociated(ctx, iterator)
+}
+
+// VotingClosedClaimsFrom gets up to limit claims waiting to be resolved, starting at startID
+func (k Keeper) VotingClosedClaimsFrom(ctx sdk.Context, startID uint64, limit int) (claims Claims) {
+	store := k.store(ctx)
+	iterator := store.Iterator(votingClosedClaimKey(startID), sdk.PrefixEndBytes(VotingClosedClaimsPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid() && len(claims) < limit; iterator.Next() {
+		var claimID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &claimID)
+		claim, ok := k.Claim(ctx, claimID)
+		if ok {
+			claims = append(claims, claim)
+		}
+	}
+
+	return claims
+}
+
+// ResolveClaim marks a claim with closed voting as resolved
+func (k Keeper) ResolveClaim(ctx sdk.Context, id uint64) sdk.Error {
+	claim, ok := k.Claim(ctx, id)
+	if !ok {
+		return ErrUnknownClaim(id)
+	}
+	if claim.Status != ClaimVotingClosed {
+		return ErrInvalidClaimStatus(id, claim.Status)
+	}
+	claim.Status = ClaimResolved
+	claim.ResolvedTime = ctx.BlockHeader().Time
+	k.setClaim(ctx, claim)
+	k.store(ctx).Delete(votingClosedClaimKey(id))
+
+	return nil
+}
+
+// verdict decides which side of a claim reached the majority threshold
+func (k Keeper) verdict(ctx sdk.Context, claim Claim) app.StakeDistributionResultsType {
+	total := claim.TotalBacked.Amount.Add(claim.TotalChallenged.Amount)
+	if total.IsZero() {
+		return app.DistributionMajorityNotReached
+	}
+	majority := k.GetParams(ctx).MajorityPercent
+	backed := sdk.NewDecFromInt(claim.TotalBacked.Amount).QuoInt(total)
+	challenged := sdk.NewDecFromInt(claim.TotalChallenged.Amount).QuoInt(total)
+
+	switch {
+	case backed.GTE(majority):
+		return app.DistributionBackersWin
+	case challenged.GTE(majority):
+		return app.DistributionChallengersWin
+	default:
+		return app.DistributionMajorityNotReached
+	}
+}
+
 // AddAdmin adds a new admin
 func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) (err sdk.Error) {
 	params := k.GetParams(ctx)
@@ -333,6 +407,26 @@ func (k Keeper) setCreatedTimeClaim(ctx sdk.Context, createdTime time.Time, clai
 	store.Set(createdTimeClaimKey(createdTime, claimID), bz)
 }
 
+// insertVotingClaimQueue inserts a claim into the voting queue at votingEndTime
+func (k Keeper) insertVotingClaimQueue(ctx sdk.Context, claimID uint64, votingEndTime time.Time) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(votingClaimQueueKey(votingEndTime, claimID), bz)
+}
+
+// setVotingClosedClaim marks a claim as waiting to be resolved
+func (k Keeper) setVotingClosedClaim(ctx sdk.Context, claimID uint64) {
+	store := k.store(ctx)
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(claimID)
+	store.Set(votingClosedClaimKey(claimID), bz)
+}
+
+// votingClaimQueueIterator returns an sdk.Iterator for all claims in the voting queue that end by votingEndTime
+func (k Keeper) votingClaimQueueIterator(ctx sdk.Context, votingEndTime time.Time) sdk.Iterator {
+	store := k.store(ctx)
+	return store.Iterator(VotingClaimQueuePrefix, sdk.PrefixEndBytes(votingClaimsByTimeKey(votingEndTime)))
+}
+
 // claimsIterator returns an sdk.Iterator for claims from startClaimID to endClaimID
 func (k Keeper) claimsIterator(ctx sdk.Context, startClaimID, endClaimID uint64) sdk.Iterator {
 	store := k.store(ctx)
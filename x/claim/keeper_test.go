@@ -171,6 +171,30 @@ func TestAddAdmin_CreatorNotAuthorised(t *testing.T) {
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
 
+func TestUpdateParams_Invalid(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).ClaimAdmins[0]
+
+	updates := DefaultParams()
+	updates.VotingPeriod = 0
+	err := keeper.UpdateParams(ctx, admin, updates, []string{"voting_period"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, DefaultParams().VotingPeriod, keeper.GetParams(ctx).VotingPeriod)
+
+	updates.MajorityPercent = sdk.NewDecWithPrec(15, 1)
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"majority_percent"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+
+	updates.MajorityPercent = sdk.NewDecWithPrec(2, 1)
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"majority_percent"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+
+	updates.MajorityPercent = sdk.NewDecWithPrec(66, 2)
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"majority_percent"})
+	assert.NoError(t, err)
+	assert.True(t, sdk.NewDecWithPrec(66, 2).Equal(keeper.GetParams(ctx).MajorityPercent))
+}
+
 func TestRemoveAdmin_Success(t *testing.T) {
 	ctx, keeper := mockDB()
 
@@ -225,3 +249,60 @@ func TestEditClaim_ErrAddressNotAuthorised(t *testing.T) {
 
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestKeeper_ClaimVotingLifecycle(t *testing.T) {
+	ctx, keeper := mockDB()
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+	claim := createFakeClaim(ctx, keeper)
+	challenged := createFakeClaim(ctx, keeper)
+	undecided := createFakeClaim(ctx, keeper)
+
+	keeper.AddBackingStake(ctx, claim.ID, sdk.NewInt64Coin(app.StakeDenom, 60*app.Shanev))
+	keeper.AddChallengeStake(ctx, claim.ID, sdk.NewInt64Coin(app.StakeDenom, 40*app.Shanev))
+	keeper.AddBackingStake(ctx, challenged.ID, sdk.NewInt64Coin(app.StakeDenom, 10*app.Shanev))
+	keeper.AddChallengeStake(ctx, challenged.ID, sdk.NewInt64Coin(app.StakeDenom, 90*app.Shanev))
+	keeper.AddBackingStake(ctx, undecided.ID, sdk.NewInt64Coin(app.StakeDenom, 50*app.Shanev))
+	keeper.AddChallengeStake(ctx, undecided.ID, sdk.NewInt64Coin(app.StakeDenom, 50*app.Shanev))
+	for _, c := range []Claim{claim, challenged, undecided} {
+		err := keeper.SetFirstArgumentTime(ctx, c.ID, now)
+		assert.NoError(t, err)
+	}
+
+	c, ok := keeper.Claim(ctx, claim.ID)
+	assert.True(t, ok)
+	assert.Equal(t, ClaimOpen, c.Status)
+	assert.True(t, now.Add(keeper.GetParams(ctx).VotingPeriod).Equal(c.VotingEndTime))
+
+	EndBlocker(ctx, keeper)
+	assert.Len(t, keeper.VotingClosedClaims(ctx), 0)
+	err := keeper.ResolveClaim(ctx, claim.ID)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidClaimStatus, err.Code())
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: c.VotingEndTime})
+	EndBlocker(ctx, keeper)
+	closed := keeper.VotingClosedClaims(ctx)
+	assert.Len(t, closed, 3)
+
+	c, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, ClaimVotingClosed, c.Status)
+	assert.Equal(t, app.DistributionBackersWin, c.Verdict)
+	c, _ = keeper.Claim(ctx, challenged.ID)
+	assert.Equal(t, app.DistributionChallengersWin, c.Verdict)
+	c, _ = keeper.Claim(ctx, undecided.ID)
+	assert.Equal(t, app.DistributionMajorityNotReached, c.Verdict)
+
+	err = keeper.ResolveClaim(ctx, claim.ID)
+	assert.NoError(t, err)
+	c, _ = keeper.Claim(ctx, claim.ID)
+	assert.Equal(t, ClaimResolved, c.Status)
+	assert.Len(t, keeper.VotingClosedClaims(ctx), 2)
+
+	from := keeper.VotingClosedClaimsFrom(ctx, 0, 1)
+	assert.Len(t, from, 1)
+	assert.Equal(t, challenged.ID, from[0].ID)
+	from = keeper.VotingClosedClaimsFrom(ctx, challenged.ID+1, 10)
+	assert.Len(t, from, 1)
+	assert.Equal(t, undecided.ID, from[0].ID)
+}
@@ -15,6 +15,9 @@ import (
 // - 0x10<communityID_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x11<creator_Bytes><claimID_Bytes>: claimID_Bytes
 // - 0x12<createdTime_Bytes><claimID_Bytes>: claimID_Bytes
+//
+// - 0x20<votingEndTime_Bytes><claimID_Bytes>: claimID_Bytes
+// - 0x21<claimID_Bytes>: claimID_Bytes
 var (
 	ClaimsKeyPrefix = []byte{0x00}
 	ClaimIDKey      = []byte{0x01}
@@ -22,6 +25,9 @@ var (
 	CommunityClaimsPrefix   = []byte{0x10}
 	CreatorClaimsPrefix     = []byte{0x11}
 	CreatedTimeClaimsPrefix = []byte{0x12}
+
+	VotingClaimQueuePrefix   = []byte{0x20}
+	VotingClosedClaimsPrefix = []byte{0x21}
 )
 
 // key for getting a specific claim from the store
@@ -58,3 +64,21 @@ func createdTimeClaimKey(createdTime time.Time, claimID uint64) []byte {
 	bz := sdk.Uint64ToBigEndian(claimID)
 	return append(createdTimeClaimsKey(createdTime), bz...)
 }
+
+// votingClaimQueueKey
+// 0x20<voting_end_time><claim_id>
+func votingClaimQueueKey(votingEndTime time.Time, claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(votingClaimsByTimeKey(votingEndTime), bz...)
+}
+
+func votingClaimsByTimeKey(votingEndTime time.Time) []byte {
+	return append(VotingClaimQueuePrefix, sdk.FormatTimeBytes(votingEndTime)...)
+}
+
+// votingClosedClaimKey
+// 0x21<claim_id>
+func votingClosedClaimKey(claimID uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(claimID)
+	return append(VotingClosedClaimsPrefix, bz...)
+}
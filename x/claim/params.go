@@ -3,6 +3,7 @@ package claim
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -10,24 +11,30 @@ import (
 
 // Keys for params
 var (
-	KeyMinClaimLength = []byte("minClaimLength")
-	KeyMaxClaimLength = []byte("maxClaimLength")
-	KeyClaimAdmins    = []byte("claimAdmins")
+	KeyMinClaimLength  = []byte("minClaimLength")
+	KeyMaxClaimLength  = []byte("maxClaimLength")
+	KeyClaimAdmins     = []byte("claimAdmins")
+	KeyVotingPeriod    = []byte("votingPeriod")
+	KeyMajorityPercent = []byte("majorityPercent")
 )
 
 // Params holds parameters for a Claim
 type Params struct {
-	MinClaimLength int              `json:"min_claim_length"`
-	MaxClaimLength int              `json:"max_claim_length"`
-	ClaimAdmins    []sdk.AccAddress `json:"claim_admins"`
+	MinClaimLength  int              `json:"min_claim_length"`
+	MaxClaimLength  int              `json:"max_claim_length"`
+	ClaimAdmins     []sdk.AccAddress `json:"claim_admins"`
+	VotingPeriod    time.Duration    `json:"voting_period"`
+	MajorityPercent sdk.Dec          `json:"majority_percent"`
 }
 
 // DefaultParams is the Claim params for testing
 func DefaultParams() Params {
 	return Params{
-		MinClaimLength: 25,
-		MaxClaimLength: 140,
-		ClaimAdmins:    []sdk.AccAddress{},
+		MinClaimLength:  25,
+		MaxClaimLength:  140,
+		ClaimAdmins:     []sdk.AccAddress{},
+		VotingPeriod:    time.Hour * 24 * 6,
+		MajorityPercent: sdk.NewDecWithPrec(51, 2),
 	}
 }
 
@@ -37,6 +44,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMinClaimLength, Value: &p.MinClaimLength},
 		{Key: KeyMaxClaimLength, Value: &p.MaxClaimLength},
 		{Key: KeyClaimAdmins, Value: &p.ClaimAdmins},
+		{Key: KeyVotingPeriod, Value: &p.VotingPeriod},
+		{Key: KeyMajorityPercent, Value: &p.MajorityPercent},
 	}
 }
 
@@ -67,11 +76,34 @@ func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Pa
 
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := validateParams(updated); err != nil {
+		return ErrInvalidParams(err)
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
 }
 
+// validateParams checks the params of a genesis or an update
+func validateParams(p Params) error {
+	if p.MinClaimLength < 1 {
+		return fmt.Errorf("Param: MinClaimLength must have a positive value")
+	}
+	if p.MaxClaimLength < 1 {
+		return fmt.Errorf("Param: MaxClaimLength must have a positive value")
+	}
+	if p.VotingPeriod <= 0 {
+		return fmt.Errorf("Param: VotingPeriod must have a positive value")
+	}
+	if p.MajorityPercent.IsNil() ||
+		p.MajorityPercent.LTE(sdk.NewDecWithPrec(5, 1)) ||
+		p.MajorityPercent.GT(sdk.OneDec()) {
+		return fmt.Errorf("Param: MajorityPercent must be greater than 0.5 and at most 1")
+	}
+
+	return nil
+}
+
 func (k Keeper) getUpdatedParams(current Params, updates Params, updatedFields []string) Params {
 	updated := current
 	mapParams(updates, func(param string, index int, field reflect.StructField) {
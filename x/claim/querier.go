@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/ahmedaly113/ahchain/types"
 )
 
 // query endpoints
@@ -27,6 +29,11 @@ type QueryClaimParams struct {
 	ID uint64 `json:"id"`
 }
 
+// QueryAllClaimsParams for paginating over all claims
+type QueryAllClaimsParams struct {
+	Pagination app.Pagination `json:"pagination"`
+}
+
 // QueryClaimsParams for many claim
 type QueryClaimsParams struct {
 	IDs []uint64 `json:"ids"`
@@ -34,7 +41,8 @@ type QueryClaimsParams struct {
 
 // QueryCommunityClaimsParams for community claims
 type QueryCommunityClaimsParams struct {
-	CommunityID string `json:"community_id"`
+	CommunityID string         `json:"community_id"`
+	Pagination  app.Pagination `json:"pagination"`
 }
 
 // QueryCommunitiesClaimsParams for communities claims
@@ -44,7 +52,8 @@ type QueryCommunitiesClaimsParams struct {
 
 // QueryCreatorClaimsParams for community claims
 type QueryCreatorClaimsParams struct {
-	Creator sdk.AccAddress `json:"creator"`
+	Creator    sdk.AccAddress `json:"creator"`
+	Pagination app.Pagination `json:"pagination"`
 }
 
 // QueryClaimsIDRangeParams for claims by an id range
@@ -58,6 +67,12 @@ type QueryClaimsTimeParams struct {
 	CreatedTime time.Time `json:"created_time"`
 }
 
+// ClaimsPage is returned by claim list queries when pagination is requested
+type ClaimsPage struct {
+	Claims     Claims `json:"claims"`
+	NextCursor string `json:"next_cursor"`
+}
+
 // NewQuerier returns a function that handles queries on the KVStore
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
@@ -104,9 +119,31 @@ func queryClaim(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 }
 
 func queryClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	claims := keeper.Claims(ctx)
+	var params QueryAllClaimsParams
+	if len(req.Data) > 0 {
+		codecErr := ModuleCodec.UnmarshalJSON(req.Data, &params)
+		if codecErr != nil {
+			return nil, ErrJSONParse(codecErr)
+		}
+	}
+	if !params.Pagination.Paginated() {
+		return mustMarshal(keeper.Claims(ctx))
+	}
 
-	return mustMarshal(claims)
+	page := ClaimsPage{Claims: make(Claims, 0)}
+	next, err := app.PaginateStore(keeper.store(ctx), ClaimsKeyPrefix, params.Pagination, app.SortDesc,
+		func(value []byte) bool {
+			var claim Claim
+			keeper.codec.MustUnmarshalBinaryLengthPrefixed(value, &claim)
+			page.Claims = append(page.Claims, claim)
+			return true
+		})
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page.NextCursor = next
+
+	return mustMarshal(page)
 }
 
 func queryClaimsByIDs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -134,6 +171,9 @@ func queryCommunityClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if codecErr != nil {
 		return nil, ErrJSONParse(codecErr)
 	}
+	if params.Pagination.Paginated() {
+		return queryAssociatedClaimsPage(ctx, keeper, communityClaimsKey(params.CommunityID), params.Pagination)
+	}
 	claims := keeper.CommunityClaims(ctx, params.CommunityID)
 
 	return mustMarshal(claims)
@@ -160,6 +200,9 @@ func queryCreatorClaims(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	if codecErr != nil {
 		return nil, ErrJSONParse(codecErr)
 	}
+	if params.Pagination.Paginated() {
+		return queryAssociatedClaimsPage(ctx, keeper, creatorClaimsKey(params.Creator), params.Pagination)
+	}
 	claims := keeper.CreatorClaims(ctx, params.Creator)
 
 	return mustMarshal(claims)
@@ -198,6 +241,28 @@ func queryClaimsAfterTime(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return mustMarshal(claims)
 }
 
+// queryAssociatedClaimsPage returns a page of the claims indexed under prefix, newest first by default
+func queryAssociatedClaimsPage(ctx sdk.Context, keeper Keeper, prefix []byte, pagination app.Pagination) ([]byte, sdk.Error) {
+	page := ClaimsPage{Claims: make(Claims, 0)}
+	next, err := app.PaginateStore(keeper.store(ctx), prefix, pagination, app.SortDesc,
+		func(value []byte) bool {
+			var claimID uint64
+			keeper.codec.MustUnmarshalBinaryLengthPrefixed(value, &claimID)
+			claim, ok := keeper.Claim(ctx, claimID)
+			if !ok {
+				return false
+			}
+			page.Claims = append(page.Claims, claim)
+			return true
+		})
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	page.NextCursor = next
+
+	return mustMarshal(page)
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/ahmedaly113/ahchain/types"
 )
 
 const custom = "custom"
@@ -106,6 +108,60 @@ func TestQueryCommunityClaims(t *testing.T) {
 	require.Equal(t, 1, len(claims))
 }
 
+func TestQueryCommunityClaims_Paginated(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	for i := 0; i < 5; i++ {
+		fakeClaim(ctx, keeper, "crypto")
+	}
+	querier := NewQuerier(keeper)
+	queryParams := QueryCommunityClaimsParams{
+		CommunityID: "crypto",
+		Pagination:  app.Pagination{Limit: 2},
+	}
+
+	ids := make([]uint64, 0)
+	for {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, QueryCommunityClaims}, "/"),
+			Data: ModuleCodec.MustMarshalJSON(queryParams),
+		}
+		resBytes, err := querier(ctx, []string{QueryCommunityClaims}, query)
+		require.NoError(t, err)
+
+		var page ClaimsPage
+		cdcErr := ModuleCodec.UnmarshalJSON(resBytes, &page)
+		require.NoError(t, cdcErr)
+		require.True(t, len(page.Claims) <= 2)
+		for _, c := range page.Claims {
+			ids = append(ids, c.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		queryParams.Pagination.Cursor = page.NextCursor
+	}
+	// newest first by default
+	require.Equal(t, []uint64{5, 4, 3, 2, 1}, ids)
+
+	queryParams.Pagination = app.Pagination{Limit: 2, SortOrder: app.SortAsc}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QueryCommunityClaims}, "/"),
+		Data: ModuleCodec.MustMarshalJSON(queryParams),
+	}
+	resBytes, err := querier(ctx, []string{QueryCommunityClaims}, query)
+	require.NoError(t, err)
+	var page ClaimsPage
+	require.NoError(t, ModuleCodec.UnmarshalJSON(resBytes, &page))
+	require.Equal(t, uint64(1), page.Claims[0].ID)
+
+	queryParams.Pagination = app.Pagination{Limit: 2, Cursor: "not base64!"}
+	query.Data = ModuleCodec.MustMarshalJSON(queryParams)
+	_, err = querier(ctx, []string{QueryCommunityClaims}, query)
+	require.Error(t, err)
+	require.Equal(t, ErrorCodeInvalidQueryParams, err.Code())
+}
+
 func TestQueryCommunitiesClaims(t *testing.T) {
 	ctx, keeper := mockDB()
 
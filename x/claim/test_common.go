@@ -20,7 +20,8 @@ import (
 var _ AccountKeeper = accKeeper{}
 
 type accKeeper struct {
-	Jailed bool
+	Jailed      bool
+	Deactivated bool
 }
 
 // IsJailed ...
@@ -28,6 +29,11 @@ func (ak accKeeper) IsJailed(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Er
 	return ak.Jailed, nil
 }
 
+// IsDeactivated ...
+func (ak accKeeper) IsDeactivated(ctx sdk.Context, addr sdk.AccAddress) (bool, sdk.Error) {
+	return ak.Deactivated, nil
+}
+
 func mockDB() (sdk.Context, Keeper) {
 	db := dbm.NewMemDB()
 
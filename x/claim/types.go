@@ -15,8 +15,36 @@ const (
 	QuerierRoute      = ModuleName
 	StoreKey          = ModuleName
 	DefaultParamspace = ModuleName
+
+	EventTypeClaimVotingClosed = "claim-voting-closed"
+	AttributeKeyClaimID        = "claim-id"
+	AttributeKeyVerdict        = "verdict"
+)
+
+// ClaimStatus defines the lifecycle state of a claim
+type ClaimStatus byte
+
+// Claim lifecycle states
+const (
+	ClaimOpen ClaimStatus = iota
+	ClaimVotingClosed
+	ClaimResolved
 )
 
+// ClaimStatusName maps a ClaimStatus to its name
+var ClaimStatusName = []string{
+	ClaimOpen:         "ClaimOpen",
+	ClaimVotingClosed: "ClaimVotingClosed",
+	ClaimResolved:     "ClaimResolved",
+}
+
+func (s ClaimStatus) String() string {
+	if int(s) >= len(ClaimStatusName) {
+		return "Unknown"
+	}
+	return ClaimStatusName[s]
+}
+
 // Claim stores data about a claim
 type Claim struct {
 	ID                uint64         `json:"id"`
@@ -29,6 +57,11 @@ type Claim struct {
 	TotalChallenged   sdk.Coin       `json:"total_challenged,omitempty"`
 	CreatedTime       time.Time      `json:"created_time"`
 	FirstArgumentTime time.Time      `json:"first_argument_time"`
+
+	Status        ClaimStatus                      `json:"status"`
+	VotingEndTime time.Time                        `json:"voting_end_time"`
+	Verdict       app.StakeDistributionResultsType `json:"verdict"`
+	ResolvedTime  time.Time                        `json:"resolved_time"`
 }
 
 // Claims is an array of claims
@@ -55,6 +88,7 @@ func (c Claim) String() string {
   Body:		   %s
   Creator:     %s
   Source:      %s
-  CreatedTime  %s`,
-		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String())
+  CreatedTime  %s
+  Status       %s`,
+		c.ID, c.CommunityID, c.Body, c.Creator.String(), c.Source.String(), c.CreatedTime.String(), c.Status.String())
 }
@@ -8,6 +8,7 @@ func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgAddAdmin{}, "community/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "community/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "community/MsgUpdateParams", nil)
+	c.RegisterConcrete(MsgSetStakingOverrides{}, "community/MsgSetStakingOverrides", nil)
 }
 
 // ModuleCodec encodes module codec
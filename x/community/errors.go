@@ -14,6 +14,7 @@ const (
 	ErrorCodeInvalidCommunityMsg  sdk.CodeType = 802
 	ErrorCodeAddressNotAuthorised sdk.CodeType = 803
 	ErrorCodeJSONParsing          sdk.CodeType = 804
+	ErrorCodeInvalidOverrides     sdk.CodeType = 805
 )
 
 // ErrCommunityNotFound throws an error when the searched category is not found
@@ -35,3 +36,8 @@ func ErrAddressNotAuthorised() sdk.Error {
 func ErrJSONParse(err error) sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeJSONParsing, "JSON parsing error: "+err.Error())
 }
+
+// ErrInvalidOverrides throws an error when staking overrides have invalid values
+func ErrInvalidOverrides(err error) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidOverrides, "Invalid staking overrides: "+err.Error())
+}
@@ -71,5 +71,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Param: CommunityAdmins, must have atleast one admin")
 	}
 
+	for _, community := range data.Communities {
+		if err := community.StakingOverrides.Validate(); err != nil {
+			return fmt.Errorf("Community %s: invalid staking overrides, %s", community.ID, err)
+		}
+	}
+
 	return nil
 }
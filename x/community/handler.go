@@ -18,6 +18,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRemoveAdmin(ctx, k, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, k, msg)
+		case MsgSetStakingOverrides:
+			return handleMsgSetStakingOverrides(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized community message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -104,3 +106,23 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgSetStakingOverrides(ctx sdk.Context, k Keeper, msg MsgSetStakingOverrides) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	community, err := k.SetStakingOverrides(ctx, msg.CommunityID, msg.Overrides, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(community)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
@@ -3,6 +3,7 @@ package community
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -88,6 +89,25 @@ func TestHandleMsgUpdateParams(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestHandleMsgSetStakingOverrides(t *testing.T) {
+	ctx, keeper := mockDB()
+	handler := NewHandler(keeper)
+	assert.NotNil(t, handler) // assert handler is present
+
+	period := time.Hour * 24 * 14
+	creator := keeper.GetParams(ctx).CommunityAdmins[0]
+	msg := NewMsgSetStakingOverrides("crypto", StakingOverrides{Period: &period}, creator)
+	assert.NotNil(t, msg) // assert msgs can be created
+
+	result := handler(ctx, msg)
+	assert.True(t, result.IsOK())
+	var community Community
+	err := ModuleCodec.UnmarshalJSON(result.Data, &community)
+	assert.NoError(t, err)
+	assert.Equal(t, "crypto", community.ID)
+	assert.Equal(t, period, *community.StakingOverrides.Period)
+}
+
 func TestByzantineMsg(t *testing.T) {
 	ctx, keeper := mockDB()
 
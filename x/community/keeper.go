@@ -74,6 +74,25 @@ func (k Keeper) Communities(ctx sdk.Context) (communities []Community) {
 	return
 }
 
+// SetStakingOverrides replaces the staking params overridden by a community
+func (k Keeper) SetStakingOverrides(ctx sdk.Context, id string, overrides StakingOverrides, creator sdk.AccAddress) (community Community, err sdk.Error) {
+	if !k.isAdmin(ctx, creator) {
+		return community, ErrAddressNotAuthorised()
+	}
+	if validationErr := overrides.Validate(); validationErr != nil {
+		return community, ErrInvalidOverrides(validationErr)
+	}
+	community, err = k.Community(ctx, id)
+	if err != nil {
+		return
+	}
+	community.StakingOverrides = overrides
+	k.setCommunity(ctx, community)
+	logger(ctx).Info(fmt.Sprintf("Updated staking overrides of community %s", id))
+
+	return community, nil
+}
+
 // AddAdmin adds a new admin
 func (k Keeper) AddAdmin(ctx sdk.Context, admin, creator sdk.AccAddress) (err sdk.Error) {
 	params := k.GetParams(ctx)
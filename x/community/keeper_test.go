@@ -2,7 +2,9 @@ package community
 
 import (
 	"testing"
+	"time"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -175,3 +177,64 @@ func TestRemoveAdmin_RemoverNotAuthorised(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestSetStakingOverrides_Success(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	id, name, description := getFakeCommunityParams()
+	creator := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.NewCommunity(ctx, id, name, description, creator)
+	assert.Nil(t, err)
+
+	period := time.Hour * 24 * 14
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20)
+	overrides := StakingOverrides{Period: &period, UpvoteStake: &upvoteStake}
+	community, err := keeper.SetStakingOverrides(ctx, id, overrides, creator)
+	assert.Nil(t, err)
+	assert.Equal(t, overrides, community.StakingOverrides)
+
+	stored, err := keeper.Community(ctx, id)
+	assert.Nil(t, err)
+	assert.Equal(t, period, *stored.StakingOverrides.Period)
+	assert.Equal(t, upvoteStake, *stored.StakingOverrides.UpvoteStake)
+	assert.Nil(t, stored.StakingOverrides.InterestRate)
+}
+
+func TestSetStakingOverrides_CreatorNotAuthorised(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	id, name, description := getFakeCommunityParams()
+	creator := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.NewCommunity(ctx, id, name, description, creator)
+	assert.Nil(t, err)
+
+	period := time.Hour
+	invalidCreator := sdk.AccAddress([]byte{1, 2})
+	_, err = keeper.SetStakingOverrides(ctx, id, StakingOverrides{Period: &period}, invalidCreator)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
+}
+
+func TestSetStakingOverrides_Invalid(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	id, name, description := getFakeCommunityParams()
+	creator := keeper.GetParams(ctx).CommunityAdmins[0]
+	_, err := keeper.NewCommunity(ctx, id, name, description, creator)
+	assert.Nil(t, err)
+
+	wrongDenom := sdk.NewInt64Coin("crypto", 10)
+	_, err = keeper.SetStakingOverrides(ctx, id, StakingOverrides{UpvoteStake: &wrongDenom}, creator)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidOverrides, err.Code())
+
+	maxArguments := 0
+	_, err = keeper.SetStakingOverrides(ctx, id, StakingOverrides{MaxArgumentsPerClaim: &maxArguments}, creator)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidOverrides, err.Code())
+
+	period := time.Hour
+	_, err = keeper.SetStakingOverrides(ctx, "unknown", StakingOverrides{Period: &period}, creator)
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeCommunityNotFound, err.Code())
+}
@@ -15,6 +15,8 @@ const (
 	TypeMsgRemoveAdmin = "remove_admin"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgSetStakingOverrides represents the type of message for overriding staking params of a community
+	TypeMsgSetStakingOverrides = "set_staking_overrides"
 )
 
 // MsgNewCommunity defines the message to add a new admin
@@ -186,3 +188,51 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgSetStakingOverrides defines the message to override staking params for a community
+type MsgSetStakingOverrides struct {
+	CommunityID string           `json:"community_id"`
+	Overrides   StakingOverrides `json:"overrides"`
+	Creator     sdk.AccAddress   `json:"creator"`
+}
+
+// NewMsgSetStakingOverrides returns the message to override staking params for a community
+func NewMsgSetStakingOverrides(communityID string, overrides StakingOverrides, creator sdk.AccAddress) MsgSetStakingOverrides {
+	return MsgSetStakingOverrides{
+		CommunityID: communityID,
+		Overrides:   overrides,
+		Creator:     creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSetStakingOverrides) ValidateBasic() sdk.Error {
+	if len(msg.CommunityID) == 0 {
+		return ErrInvalidCommunityMsg("Community ID can't be empty")
+	}
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+	if err := msg.Overrides.Validate(); err != nil {
+		return ErrInvalidOverrides(err)
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgSetStakingOverrides) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetStakingOverrides) Type() string { return TypeMsgSetStakingOverrides }
+
+// GetSignBytes implements Msg
+func (msg MsgSetStakingOverrides) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgSetStakingOverrides) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
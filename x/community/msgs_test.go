@@ -3,6 +3,7 @@ package community
 import (
 	"testing"
 
+	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,3 +104,24 @@ func TestMsgRemoveAdmin_InvalidAdmin(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgSetStakingOverrides_Success(t *testing.T) {
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20)
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgSetStakingOverrides("crypto", StakingOverrides{UpvoteStake: &upvoteStake}, creator)
+	err := msg.ValidateBasic()
+	assert.Nil(t, err)
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgSetStakingOverrides, msg.Type())
+}
+
+func TestMsgSetStakingOverrides_InvalidOverrides(t *testing.T) {
+	negativeRate := sdk.NewDecWithPrec(-1, 2)
+	creator := sdk.AccAddress([]byte{1, 2})
+
+	msg := NewMsgSetStakingOverrides("crypto", StakingOverrides{InterestRate: &negativeRate}, creator)
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrorCodeInvalidOverrides, err.Code())
+}
@@ -3,6 +3,9 @@ package community
 import (
 	"fmt"
 	"time"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Defines module constants
@@ -14,10 +17,45 @@ const (
 
 // Community represents the state of a community on ahmedaly113
 type Community struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	CreatedTime time.Time `json:"created_time,omitempty"`
+	ID               string           `json:"id"`
+	Name             string           `json:"name"`
+	Description      string           `json:"description,omitempty"`
+	CreatedTime      time.Time        `json:"created_time,omitempty"`
+	StakingOverrides StakingOverrides `json:"staking_overrides"`
+}
+
+// StakingOverrides replaces staking params for the stakes and arguments of a single community.
+// Fields left nil keep the global staking param.
+type StakingOverrides struct {
+	InterestRate          *sdk.Dec       `json:"interest_rate,omitempty"`
+	Period                *time.Duration `json:"period,omitempty"`
+	ArgumentCreationStake *sdk.Coin      `json:"argument_creation_stake,omitempty"`
+	UpvoteStake           *sdk.Coin      `json:"upvote_stake,omitempty"`
+	MaxArgumentsPerClaim  *int           `json:"max_arguments_per_claim,omitempty"`
+}
+
+// Validate checks the values of the overrides that are set
+func (o StakingOverrides) Validate() error {
+	if o.InterestRate != nil && o.InterestRate.IsNegative() {
+		return fmt.Errorf("interest rate can't be negative")
+	}
+	if o.Period != nil && *o.Period <= 0 {
+		return fmt.Errorf("period must be positive")
+	}
+	if o.ArgumentCreationStake != nil && !validStake(*o.ArgumentCreationStake) {
+		return fmt.Errorf("argument creation stake must be a positive amount of %s", app.StakeDenom)
+	}
+	if o.UpvoteStake != nil && !validStake(*o.UpvoteStake) {
+		return fmt.Errorf("upvote stake must be a positive amount of %s", app.StakeDenom)
+	}
+	if o.MaxArgumentsPerClaim != nil && *o.MaxArgumentsPerClaim <= 0 {
+		return fmt.Errorf("max arguments per claim must be positive")
+	}
+	return nil
+}
+
+func validStake(stake sdk.Coin) bool {
+	return stake.Denom == app.StakeDenom && stake.IsValid() && stake.IsPositive()
 }
 
 // Communities is a slice of communites
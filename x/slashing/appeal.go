@@ -0,0 +1,329 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/ahmedaly113/ahchain/x/bank"
+	"github.com/ahmedaly113/ahchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppealSlash files an appeal against the punishment of an argument.
+// Only the argument creator can appeal, once, within AppealWindow of the punishment.
+func (k Keeper) AppealSlash(ctx sdk.Context, slashID uint64, reason string, creator sdk.AccAddress) (appeal Appeal, err sdk.Error) {
+	params := k.GetParams(ctx)
+	creator, err = k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return
+	}
+	slash, err := k.Slash(ctx, slashID)
+	if err != nil {
+		return
+	}
+	argument, ok := k.stakingKeeper.Argument(ctx, slash.ArgumentID)
+	if !ok {
+		return appeal, ErrInvalidArgument(slash.ArgumentID)
+	}
+	if !argument.Creator.Equals(creator) {
+		return appeal, ErrAddressNotAuthorised()
+	}
+	punishment, ok := k.Punishment(ctx, argument.ID)
+	if !ok {
+		return appeal, ErrNotPunished(argument.ID)
+	}
+	if _, ok := k.ArgumentAppeal(ctx, argument.ID); ok {
+		return appeal, ErrAppealExists(argument.ID)
+	}
+	if ctx.BlockHeader().Time.After(punishment.CreatedTime.Add(params.AppealWindow)) {
+		return appeal, ErrAppealWindowClosed(argument.ID)
+	}
+	if len(reason) > params.MaxDetailedReasonLength {
+		return appeal, ErrInvalidSlashReason(fmt.Sprintf("Appeal reason must be under %d chars.", params.MaxDetailedReasonLength))
+	}
+
+	appealID, err := k.appealID(ctx)
+	if err != nil {
+		return
+	}
+	appeal = Appeal{
+		ID:          appealID,
+		ArgumentID:  argument.ID,
+		SlashID:     slashID,
+		Reason:      reason,
+		Creator:     creator,
+		Status:      AppealPending,
+		CreatedTime: ctx.BlockHeader().Time,
+	}
+	k.setAppeal(ctx, appeal)
+	k.setAppealID(ctx, appealID+1)
+	k.setArgumentAppeal(ctx, argument.ID, appealID)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Created new appeal: %s", appeal.String()))
+
+	return appeal, nil
+}
+
+// ResolveAppeal lets a slash admin uphold or reject a pending appeal.
+// Upholding reverts the punishment of the argument.
+func (k Keeper) ResolveAppeal(ctx sdk.Context, appealID uint64, upheld bool, resolver sdk.AccAddress) (appeal Appeal, results []PunishmentResult, err sdk.Error) {
+	results = make([]PunishmentResult, 0)
+	if !k.isAdmin(ctx, resolver) {
+		return appeal, results, ErrAddressNotAuthorised()
+	}
+	appeal, err = k.Appeal(ctx, appealID)
+	if err != nil {
+		return
+	}
+	if appeal.Status != AppealPending {
+		return appeal, results, ErrAppealResolved(appealID)
+	}
+
+	appeal.Status = AppealRejected
+	if upheld {
+		punishment, ok := k.Punishment(ctx, appeal.ArgumentID)
+		if !ok {
+			return appeal, results, ErrNotPunished(appeal.ArgumentID)
+		}
+		results, err = k.revertPunishment(ctx, appeal.ID, punishment)
+		if err != nil {
+			return
+		}
+		appeal.Status = AppealUpheld
+	}
+	appeal.Resolver = resolver
+	appeal.ResolvedTime = ctx.BlockHeader().Time
+	k.setAppeal(ctx, appeal)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Resolved appeal %d with status %d", appeal.ID, appeal.Status))
+
+	return appeal, results, nil
+}
+
+// revertPunishment restores slashed stakes and interest from the user reward pool,
+// claws back curator rewards, undoes slash counts and the jail the slash led to,
+// and gives the argument back its place in the claim totals. The argument's slashes,
+// punishment and appeal are cleared so it can be slashed and appealed again.
+func (k Keeper) revertPunishment(ctx sdk.Context, appealID uint64, punishment Punishment) ([]PunishmentResult, sdk.Error) {
+	results := make([]PunishmentResult, 0)
+	// curator rewards are clawed back first so the pool can cover the restored coins
+	for _, result := range punishment.Results {
+		if result.Type != PunishmentCuratorRewarded || !result.Coin.IsPositive() {
+			continue
+		}
+		// curators may have spent their reward, only what is left is taken back
+		_, amount, err := k.bankKeeper.SafeSubtractCoin(ctx, result.AppAccAddress, result.Coin, appealID,
+			bank.TransactionCuratorRewardClawedBack, WithCommunityID(punishment.CommunityID),
+			ToModuleAccount(staking.UserRewardPoolName))
+		if err != nil {
+			return results, err
+		}
+		results = append(results, PunishmentResult{Type: PunishmentCuratorRewardClawedBack,
+			AppAccAddress: result.AppAccAddress,
+			Coin:          amount,
+		})
+	}
+	for _, result := range punishment.Results {
+		switch result.Type {
+		case PunishmentStakeSlashed:
+			if !result.Coin.IsPositive() {
+				continue
+			}
+			_, err := k.bankKeeper.AddCoin(ctx, result.AppAccAddress, result.Coin, appealID,
+				bank.TransactionStakeSlashReverted, WithCommunityID(punishment.CommunityID),
+				FromModuleAccount(staking.UserRewardPoolName))
+			if err != nil {
+				return results, err
+			}
+			results = append(results, PunishmentResult{Type: PunishmentStakeRestored,
+				AppAccAddress: result.AppAccAddress,
+				Coin:          result.Coin,
+			})
+		case PunishmentInterestSlashed:
+			if !result.Coin.IsPositive() {
+				continue
+			}
+			_, err := k.bankKeeper.AddCoin(ctx, result.AppAccAddress, result.Coin, appealID,
+				bank.TransactionInterestSlashReverted, WithCommunityID(punishment.CommunityID),
+				FromModuleAccount(staking.UserRewardPoolName))
+			if err != nil {
+				return results, err
+			}
+			k.stakingKeeper.AddEarnedCoin(ctx, result.AppAccAddress, punishment.CommunityID, result.Coin.Amount)
+			results = append(results, PunishmentResult{Type: PunishmentInterestRestored,
+				AppAccAddress: result.AppAccAddress,
+				Coin:          result.Coin,
+			})
+		case PunishmentJailed:
+			jailed, err := k.jailedBySlash(ctx, result.AppAccAddress, punishment.SlashID)
+			if err != nil {
+				return results, err
+			}
+			if !jailed {
+				continue
+			}
+			err = k.accountKeeper.UnJail(ctx, result.AppAccAddress)
+			if err != nil {
+				return results, err
+			}
+			results = append(results, PunishmentResult{Type: PunishmentUnjailed,
+				AppAccAddress: result.AppAccAddress,
+			})
+		}
+	}
+	for _, creator := range punishment.SlashedCreators {
+		err := k.accountKeeper.DecrementSlashCount(ctx, creator, punishment.SlashID)
+		if err != nil {
+			return results, err
+		}
+	}
+
+	argument, ok := k.stakingKeeper.Argument(ctx, punishment.ArgumentID)
+	if !ok {
+		return results, ErrInvalidArgument(punishment.ArgumentID)
+	}
+	// punishments recorded before the removed stake was tracked have no denom
+	if punishment.ClaimStakeRemoved.IsValid() && punishment.ClaimStakeRemoved.IsPositive() {
+		var err sdk.Error
+		switch argument.StakeType {
+		case staking.StakeBacking:
+			err = k.claimKeeper.AddBackingStake(ctx, argument.ClaimID, punishment.ClaimStakeRemoved)
+		case staking.StakeChallenge:
+			err = k.claimKeeper.AddChallengeStake(ctx, argument.ClaimID, punishment.ClaimStakeRemoved)
+		}
+		if err != nil {
+			return results, err
+		}
+	}
+	err := k.stakingKeeper.UnmarkUnhelpfulArgument(ctx, argument.ID)
+	if err != nil {
+		return results, err
+	}
+	// the argument starts over, so new slashes neither reach a stale quorum nor pay the old curators
+	k.revertArgumentSlashes(ctx, argument.ID)
+	k.store(ctx).Delete(punishmentKey(argument.ID))
+	k.store(ctx).Delete(argumentAppealKey(argument.ID))
+
+	return results, nil
+}
+
+// revertArgumentSlashes marks the slashes of an argument as reverted and drops them from its slash count and indexes
+func (k Keeper) revertArgumentSlashes(ctx sdk.Context, argumentID uint64) {
+	store := k.store(ctx)
+	for _, slash := range k.ArgumentSlashes(ctx, argumentID) {
+		slash.Reverted = true
+		k.setSlash(ctx, slash)
+		store.Delete(argumentSlashKey(argumentID, slash.ID))
+		store.Delete(argumentSlasherSlashKey(argumentID, slash.Creator, slash.ID))
+	}
+	store.Delete(slashCountKey(argumentID))
+}
+
+// jailedBySlash tells whether a user is serving a jail episode the given slash contributed to
+func (k Keeper) jailedBySlash(ctx sdk.Context, address sdk.AccAddress, slashID uint64) (bool, sdk.Error) {
+	number, err := k.accountKeeper.CurrentJailEpisode(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	episode, ok := k.accountKeeper.JailEpisode(ctx, address, number)
+	if !ok {
+		return false, nil
+	}
+	for _, slash := range episode.Slashes {
+		if slash.SlashID == slashID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Appeal returns an appeal by its ID
+func (k Keeper) Appeal(ctx sdk.Context, id uint64) (appeal Appeal, err sdk.Error) {
+	bz := k.store(ctx).Get(appealKey(id))
+	if bz == nil {
+		return appeal, ErrAppealNotFound(id)
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appeal)
+
+	return appeal, nil
+}
+
+// ArgumentAppeal returns the appeal filed against the punishment of an argument
+func (k Keeper) ArgumentAppeal(ctx sdk.Context, argumentID uint64) (Appeal, bool) {
+	bz := k.store(ctx).Get(argumentAppealKey(argumentID))
+	if bz == nil {
+		return Appeal{}, false
+	}
+	var appealID uint64
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appealID)
+	appeal, err := k.Appeal(ctx, appealID)
+	if err != nil {
+		return Appeal{}, false
+	}
+	return appeal, true
+}
+
+// Appeals gets all appeals from the KVStore
+func (k Keeper) Appeals(ctx sdk.Context) Appeals {
+	appeals := make(Appeals, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), AppealsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var appeal Appeal
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &appeal)
+		appeals = append(appeals, appeal)
+	}
+	return appeals
+}
+
+// Punishment returns the punishment of an argument
+func (k Keeper) Punishment(ctx sdk.Context, argumentID uint64) (Punishment, bool) {
+	bz := k.store(ctx).Get(punishmentKey(argumentID))
+	if bz == nil {
+		return Punishment{}, false
+	}
+	var punishment Punishment
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &punishment)
+	return punishment, true
+}
+
+// Punishments gets all punishments from the KVStore
+func (k Keeper) Punishments(ctx sdk.Context) []Punishment {
+	punishments := make([]Punishment, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), PunishmentsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var punishment Punishment
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &punishment)
+		punishments = append(punishments, punishment)
+	}
+	return punishments
+}
+
+func (k Keeper) setPunishment(ctx sdk.Context, punishment Punishment) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(punishment)
+	k.store(ctx).Set(punishmentKey(punishment.ArgumentID), bz)
+}
+
+func (k Keeper) setAppeal(ctx sdk.Context, appeal Appeal) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(appeal)
+	k.store(ctx).Set(appealKey(appeal.ID), bz)
+}
+
+func (k Keeper) setArgumentAppeal(ctx sdk.Context, argumentID, appealID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(appealID)
+	k.store(ctx).Set(argumentAppealKey(argumentID), bz)
+}
+
+func (k Keeper) appealID(ctx sdk.Context) (appealID uint64, err sdk.Error) {
+	bz := k.store(ctx).Get(AppealIDKey)
+	if bz == nil {
+		return 0, ErrAppealNotFound(appealID)
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &appealID)
+	return appealID, nil
+}
+
+func (k Keeper) setAppealID(ctx sdk.Context, appealID uint64) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(appealID)
+	k.store(ctx).Set(AppealIDKey, bz)
+}
@@ -8,6 +8,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddAdmin{}, "slashing/MsgAddAdmin", nil)
 	cdc.RegisterConcrete(MsgRemoveAdmin{}, "slashing/MsgRemoveAdmin", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "slashing/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(MsgAppealSlash{}, "slashing/MsgAppealSlash", nil)
+	cdc.RegisterConcrete(MsgResolveAppeal{}, "slashing/MsgResolveAppeal", nil)
 
 	cdc.RegisterConcrete(Slash{}, "ahchain/Slash", nil)
 }
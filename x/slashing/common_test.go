@@ -163,6 +163,7 @@ func mockDB() (sdk.Context, Keeper) {
 		accountKeeper,
 		trubankKeeper,
 		claimKeeper,
+		communityKeeper,
 		supplyKeeper,
 		paramsKeeper.Subspace(staking.DefaultParamspace),
 		staking.DefaultCodespace,
@@ -20,6 +20,12 @@ const (
 	ErrorCodeInvalidSlashReason   sdk.CodeType = 508
 	ErrorCodeAddressNotAuthorised sdk.CodeType = 509
 	ErrorCodeAlreadyUnhelpful     sdk.CodeType = 510
+	ErrorCodeNotPunished          sdk.CodeType = 511
+	ErrorCodeAppealWindowClosed   sdk.CodeType = 512
+	ErrorCodeAppealExists         sdk.CodeType = 513
+	ErrorCodeAppealNotFound       sdk.CodeType = 514
+	ErrorCodeAppealResolved       sdk.CodeType = 515
+	ErrorCodeInvalidParams        sdk.CodeType = 516
 )
 
 // ErrSlashNotFound throws an error when the searched slash is not found
@@ -71,3 +77,33 @@ func ErrAddressNotAuthorised() sdk.Error {
 func ErrAlreadyUnhelpful() sdk.Error {
 	return sdk.NewError(DefaultCodespace, ErrorCodeAlreadyUnhelpful, "The argument is already slashed")
 }
+
+// ErrNotPunished throws an error when appealing an argument that wasn't punished
+func ErrNotPunished(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeNotPunished, fmt.Sprintf("Argument %d has not been punished", argumentID))
+}
+
+// ErrAppealWindowClosed throws an error when the appeal window of a punishment has passed
+func ErrAppealWindowClosed(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealWindowClosed, fmt.Sprintf("Appeal window closed for argument %d", argumentID))
+}
+
+// ErrAppealExists throws an error when the punishment of an argument was already appealed
+func ErrAppealExists(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealExists, fmt.Sprintf("Argument %d has already been appealed", argumentID))
+}
+
+// ErrAppealNotFound throws an error when the searched appeal is not found
+func ErrAppealNotFound(id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealNotFound, fmt.Sprintf("Appeal not found with ID: %d", id))
+}
+
+// ErrAppealResolved throws an error when resolving an appeal twice
+func ErrAppealResolved(id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeAppealResolved, fmt.Sprintf("Appeal %d is already resolved", id))
+}
+
+// ErrInvalidParams throws an error when updated params are invalid
+func ErrInvalidParams(err error) sdk.Error {
+	return sdk.NewError(DefaultCodespace, ErrorCodeInvalidParams, fmt.Sprintf("Invalid params: %s", err.Error()))
+}
@@ -0,0 +1,71 @@
+package slashing
+
+import (
+	"github.com/ahmedaly113/ahchain/x/account"
+	"github.com/ahmedaly113/ahchain/x/bank"
+	"github.com/ahmedaly113/ahchain/x/staking"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountExport is the full history of a user, exported as a single bundle
+type AccountExport struct {
+	AppAccount   account.AppAccount    `json:"app_account"`
+	JailEpisodes []account.JailEpisode `json:"jail_episodes"`
+	Transactions []bank.Transaction    `json:"transactions"`
+	Arguments    []staking.Argument    `json:"arguments"`
+	Stakes       []staking.Stake       `json:"stakes"`
+	// Slashes are the slashes the user created
+	Slashes Slashes `json:"slashes"`
+	// SlashesReceived are the slashes on the arguments of the user
+	SlashesReceived Slashes      `json:"slashes_received"`
+	Punishments     []Punishment `json:"punishments"`
+}
+
+// ExportAccount gathers the history of the AppAccount an address is linked to.
+// Deactivated and closed accounts can be exported too.
+func (k Keeper) ExportAccount(ctx sdk.Context, address sdk.AccAddress) (export AccountExport, err sdk.Error) {
+	identity, err := k.accountKeeper.Identity(ctx, address)
+	if err != nil {
+		return
+	}
+	appAccount, ok := k.accountKeeper.AppAccount(ctx, identity)
+	if !ok {
+		return export, account.ErrAppAccountNotFound(address)
+	}
+
+	export = AccountExport{
+		AppAccount:      appAccount,
+		JailEpisodes:    k.accountKeeper.JailHistory(ctx, identity),
+		Transactions:    k.bankKeeper.TransactionsByAddress(ctx, identity),
+		Arguments:       k.stakingKeeper.UserArguments(ctx, identity),
+		Stakes:          k.stakingKeeper.UserStakes(ctx, identity),
+		Slashes:         k.CreatorSlashes(ctx, identity),
+		SlashesReceived: make(Slashes, 0),
+		Punishments:     make([]Punishment, 0),
+	}
+	for _, argument := range export.Arguments {
+		export.SlashesReceived = append(export.SlashesReceived, k.ArgumentSlashes(ctx, argument.ID)...)
+		if punishment, ok := k.Punishment(ctx, argument.ID); ok {
+			export.Punishments = append(export.Punishments, punishment)
+		}
+	}
+
+	return export, nil
+}
+
+// CreatorSlashes returns the slashes created by an address
+func (k Keeper) CreatorSlashes(ctx sdk.Context, creator sdk.AccAddress) Slashes {
+	slashes := make(Slashes, 0)
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), creatorSlashesKey(creator))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var slashID uint64
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &slashID)
+		slash, err := k.Slash(ctx, slashID)
+		if err != nil {
+			panic(err)
+		}
+		slashes = append(slashes, slash)
+	}
+	return slashes
+}
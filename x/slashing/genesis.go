@@ -1,22 +1,24 @@
 package slashing
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Slashes []Slash `json:"slashes"`
-	Params  Params  `json:"params"`
+	Slashes     []Slash      `json:"slashes"`
+	Punishments []Punishment `json:"punishments"`
+	Appeals     []Appeal     `json:"appeals"`
+	Params      Params       `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState() GenesisState {
 	return GenesisState{
-		Slashes: []Slash{},
-		Params:  DefaultParams(),
+		Slashes:     []Slash{},
+		Punishments: []Punishment{},
+		Appeals:     []Appeal{},
+		Params:      DefaultParams(),
 	}
 }
 
@@ -27,7 +29,16 @@ func DefaultGenesisState() GenesisState { return NewGenesisState() }
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	counter := make(map[uint64]uint64)
 	for _, slash := range data.Slashes {
+		// slashes exported before weights were recorded don't count towards a stake weighted quorum
+		if slash.Weight == (sdk.Int{}) {
+			slash.Weight = sdk.ZeroInt()
+		}
 		keeper.setSlash(ctx, slash)
+		keeper.setCreatorSlash(ctx, slash.Creator, slash.ID)
+		// slashes reverted by an upheld appeal no longer count against the argument
+		if slash.Reverted {
+			continue
+		}
 		count, ok := counter[slash.ArgumentID]
 		if !ok {
 			count = 0
@@ -35,45 +46,58 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		count = count + 1
 		counter[slash.ArgumentID] = count
 
-		keeper.setCreatorSlash(ctx, slash.Creator, slash.ID)
 		keeper.setSlashCount(ctx, slash.ArgumentID, count)
 		keeper.setArgumentSlash(ctx, slash.ArgumentID, slash.ID)
 		keeper.setArgumentSlasherSlash(ctx, slash.ArgumentID, slash.ID, slash.Creator)
 
 	}
 	keeper.setSlashID(ctx, uint64(len(data.Slashes)+1))
-	keeper.SetParams(ctx, data.Params)
-}
-
-// ExportGenesis exports the genesis state
-func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return GenesisState{
-		Slashes: keeper.Slashes(ctx),
-		Params:  keeper.GetParams(ctx),
+	for _, punishment := range data.Punishments {
+		keeper.setPunishment(ctx, punishment)
 	}
+	for _, appeal := range data.Appeals {
+		keeper.setAppeal(ctx, appeal)
+		if appeal.Status != AppealUpheld {
+			keeper.setArgumentAppeal(ctx, appeal.ArgumentID, appeal.ID)
+		}
+	}
+	keeper.setAppealID(ctx, uint64(len(data.Appeals)+1))
+	keeper.SetParams(ctx, withDefaultParams(data.Params))
 }
 
-// ValidateGenesis validates the genesis state data
-func ValidateGenesis(data GenesisState) error {
-	if data.Params.MinSlashCount < 1 {
-		return fmt.Errorf("Param: MinSlashCount, must have a positive value")
+// withDefaultParams fills the params missing from a genesis exported before they existed
+func withDefaultParams(p Params) Params {
+	defaults := DefaultParams()
+	if p.AppealWindow == 0 {
+		p.AppealWindow = defaults.AppealWindow
 	}
-
-	if data.Params.SlashMagnitude < 1 {
-		return fmt.Errorf("Param: SlashMagnitude, must have a positive value")
+	if p.QuorumMode == "" {
+		p.QuorumMode = QuorumCount
 	}
-
-	if data.Params.SlashMinStake.IsNegative() {
-		return fmt.Errorf("Param: SlashMinStake, cannot be a negative value")
+	if p.StakeWeightedQuorum == (sdk.Int{}) {
+		p.StakeWeightedQuorum = defaults.StakeWeightedQuorum
 	}
-
-	if len(data.Params.SlashAdmins) < 1 {
-		return fmt.Errorf("Param: SlashAdmins, must have atleast one admin")
+	if p.ReasonAggregation == "" {
+		p.ReasonAggregation = defaults.ReasonAggregation
+	}
+	if p.ReasonPunishments == nil {
+		p.ReasonPunishments = defaults.ReasonPunishments
 	}
+	return p
+}
 
-	if data.Params.CuratorShare.IsNegative() {
-		return fmt.Errorf("Param: CuratorShare, cannot be a negative value")
+// ExportGenesis exports the genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return GenesisState{
+		Slashes:     keeper.Slashes(ctx),
+		Punishments: keeper.Punishments(ctx),
+		Appeals:     keeper.Appeals(ctx),
+		Params:      keeper.GetParams(ctx),
 	}
+}
 
-	return nil
+// ValidateGenesis validates the genesis state data
+func ValidateGenesis(data GenesisState) error {
+	data.Params = withDefaultParams(data.Params)
+	return validateParams(data.Params)
 }
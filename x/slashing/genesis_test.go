@@ -0,0 +1,41 @@
+package slashing
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// genesis exported before slashes could be appealed
+const legacyGenesis = `{
+	"slashes": [],
+	"punishments": [],
+	"params": {
+		"min_slash_count": 5,
+		"slash_magnitude": 3,
+		"slash_min_stake": {"denom": "utru", "amount": "10000000"},
+		"slash_admins": [],
+		"curator_share": "0.250000000000000000",
+		"max_detailed_reason_length": 140
+	}
+}`
+
+func TestGenesis_LegacyParams(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	var state GenesisState
+	ModuleCodec.MustUnmarshalJSON([]byte(legacyGenesis), &state)
+	_, _, admin := getFakeKeyPubAddr()
+	state.Params.SlashAdmins = []sdk.AccAddress{admin}
+	assert.NoError(t, ValidateGenesis(state))
+
+	InitGenesis(ctx, keeper, state)
+	params := keeper.GetParams(ctx)
+	assert.Equal(t, DefaultParams().AppealWindow, params.AppealWindow)
+	assert.Equal(t, QuorumCount, params.QuorumMode)
+	assert.True(t, DefaultParams().StakeWeightedQuorum.Equal(params.StakeWeightedQuorum))
+	assert.Equal(t, DefaultParams().ReasonAggregation, params.ReasonAggregation)
+	assert.Len(t, params.ReasonPunishments, len(DefaultParams().ReasonPunishments))
+	assert.Equal(t, 140, params.MaxDetailedReasonLength)
+}
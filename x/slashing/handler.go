@@ -19,6 +19,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgRemoveAdmin(ctx, keeper, msg)
 		case MsgUpdateParams:
 			return handleMsgUpdateParams(ctx, keeper, msg)
+		case MsgAppealSlash:
+			return handleMsgAppealSlash(ctx, keeper, msg)
+		case MsgResolveAppeal:
+			return handleMsgResolveAppeal(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized slashing message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -129,3 +133,58 @@ func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) sdk.R
 		Data: res,
 	}
 }
+
+func handleMsgAppealSlash(ctx sdk.Context, k Keeper, msg MsgAppealSlash) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appeal, err := k.AppealSlash(ctx, msg.SlashID, msg.Reason, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	return sdk.Result{
+		Data: res,
+	}
+}
+
+func handleMsgResolveAppeal(ctx sdk.Context, k Keeper, msg MsgResolveAppeal) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	appeal, results, err := k.ResolveAppeal(ctx, msg.AppealID, msg.Upheld, msg.Resolver)
+	if err != nil {
+		return err.Result()
+	}
+
+	res, jsonErr := ModuleCodec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+	}
+
+	if len(results) > 0 {
+		_json, jsonErr := json.Marshal(results)
+		if jsonErr != nil {
+			return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", jsonErr)).Result()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(AttributeKeyAppealResults, string(_json)),
+			),
+		)
+	}
+
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
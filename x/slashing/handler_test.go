@@ -28,6 +28,28 @@ func TestHandle_SlashArgument(t *testing.T) {
 	assert.True(t, res.IsOK())
 }
 
+func TestHandle_AppealSlash(t *testing.T) {
+	ctx, k := mockDB()
+	handler := NewHandler(k)
+
+	staker := k.GetParams(ctx).SlashAdmins[0]
+	slasher := k.GetParams(ctx).SlashAdmins[1]
+	arg, err := k.stakingKeeper.SubmitArgument(ctx, "arg1", "summary1", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	slash, _, err := k.CreateSlash(ctx, arg.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	res := handler(ctx, NewMsgAppealSlash(slash.ID, "it is my own work", staker))
+	assert.True(t, res.IsOK())
+	var appeal Appeal
+	assert.NoError(t, ModuleCodec.UnmarshalJSON(res.Data, &appeal))
+
+	res = handler(ctx, NewMsgResolveAppeal(appeal.ID, true, slasher))
+	assert.True(t, res.IsOK())
+	assert.NoError(t, ModuleCodec.UnmarshalJSON(res.Data, &appeal))
+	assert.Equal(t, AppealUpheld, appeal.Status)
+}
+
 func TestHandleMsgAddAdmin(t *testing.T) {
 	ctx, keeper := mockDB()
 	handler := NewHandler(keeper)
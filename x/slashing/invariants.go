@@ -0,0 +1,35 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all slashing invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "unhelpful-arguments", UnhelpfulArgumentsInvariant(k))
+}
+
+// UnhelpfulArgumentsInvariant checks that arguments punished as unhelpful
+// don't have stakes left that still earn interest or can be refunded again
+func UnhelpfulArgumentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, argument := range k.stakingKeeper.Arguments(ctx) {
+			if !argument.IsUnhelpful {
+				continue
+			}
+			for _, stake := range k.stakingKeeper.ArgumentStakes(ctx, argument.ID) {
+				if !stake.Expired {
+					count++
+					msg += fmt.Sprintf("\tstake %d on unhelpful argument %d is still active\n", stake.ID, argument.ID)
+				}
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "unhelpful-arguments",
+			fmt.Sprintf("%d active stakes on unhelpful arguments\n%s", count, msg)), broken
+	}
+}
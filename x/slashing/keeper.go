@@ -50,10 +50,21 @@ func (k Keeper) CreateSlash(ctx sdk.Context,
 	slashReason SlashReason,
 	slashDetailedReason string,
 	creator sdk.AccAddress) (slash Slash, results []PunishmentResult, err sdk.Error) {
+	creator, err = k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return
+	}
 	err = k.checkJailed(ctx, creator)
 	if err != nil {
 		return
 	}
+	deactivated, err := k.accountKeeper.IsDeactivated(ctx, creator)
+	if err != nil {
+		return
+	}
+	if deactivated {
+		return slash, results, staking.ErrCodeAccountDeactivated(creator)
+	}
 	logger := k.Logger(ctx)
 	results = make([]PunishmentResult, 0)
 	err = k.validateParams(ctx, argumentID, slashDetailedReason, creator)
@@ -66,14 +77,21 @@ func (k Keeper) CreateSlash(ctx sdk.Context,
 		return
 	}
 
+	argument, ok := k.stakingKeeper.Argument(ctx, argumentID)
+	if !ok {
+		return slash, results, ErrInvalidArgument(argumentID)
+	}
+
 	slash = Slash{
-		ID:             slashID,
-		ArgumentID:     argumentID,
-		Type:           slashType,
-		Reason:         slashReason,
-		DetailedReason: slashDetailedReason,
-		Creator:        creator,
-		CreatedTime:    ctx.BlockHeader().Time,
+		ID:              slashID,
+		ArgumentID:      argumentID,
+		ArgumentVersion: argument.Version,
+		Type:            slashType,
+		Reason:          slashReason,
+		DetailedReason:  slashDetailedReason,
+		Creator:         creator,
+		Weight:          k.stakingKeeper.CommunityEarnedCoins(ctx, creator, argument.CommunityID),
+		CreatedTime:     ctx.BlockHeader().Time,
 	}
 
 	// persist the slash
@@ -91,16 +109,19 @@ func (k Keeper) CreateSlash(ctx sdk.Context,
 		return slash, results, err
 	}
 
-	slashCount := k.getSlashCount(ctx, argumentID)
-	if slashCount >= k.GetParams(ctx).MinSlashCount || k.isAdmin(ctx, creator) {
+	if k.quorumReached(ctx, argumentID) || k.isAdmin(ctx, creator) {
 		err = k.stakingKeeper.MarkUnhelpfulArgument(ctx, argumentID)
 		if err != nil {
 			return slash, results, err
 		}
-		results, err = k.punish(ctx, argumentID)
+		var punishment Punishment
+		punishment, err = k.punish(ctx, argumentID, slash.ID)
+		results = punishment.Results
 		if err != nil {
 			return slash, results, err
 		}
+		punishment.CreatedTime = ctx.BlockHeader().Time
+		k.setPunishment(ctx, punishment)
 	}
 
 	logger.Info(fmt.Sprintf("Created new slash: %s", slash.String()))
@@ -133,12 +154,33 @@ func (k Keeper) refundStake(ctx sdk.Context, stake staking.Stake, communityID st
 	return nil
 }
 
-func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult, sdk.Error) {
+// punish slashes the stakes of an unhelpful argument and rewards its curators,
+// as harshly as the reason decided from all its slashes demands.
+// The returned punishment records everything that was done so an appeal can revert it.
+func (k Keeper) punish(ctx sdk.Context, argumentID, slashID uint64) (Punishment, sdk.Error) {
+	decided := k.decidePunishment(ctx, argumentID)
+	punishment := Punishment{
+		ArgumentID:        argumentID,
+		SlashID:           slashID,
+		Reason:            decided.Reason,
+		SlashedCreators:   make([]sdk.AccAddress, 0),
+		ClaimStakeRemoved: sdk.NewCoin(app.StakeDenom, sdk.ZeroInt()),
+	}
+	punishmentResults, err := k.punishStakes(ctx, argumentID, decided, &punishment)
+	for i := range punishmentResults {
+		punishmentResults[i].Reason = decided.Reason
+	}
+	punishment.Results = punishmentResults
+	return punishment, err
+}
+
+func (k Keeper) punishStakes(ctx sdk.Context, argumentID uint64, decided ReasonPunishment, punishment *Punishment) ([]PunishmentResult, sdk.Error) {
 	stakingPool := sdk.NewCoin(app.StakeDenom, sdk.ZeroInt())
 	var communityID string
 	punishmentResults := make([]PunishmentResult, 0)
 	for _, stake := range k.stakingKeeper.ArgumentStakes(ctx, argumentID) {
 		communityID = stake.CommunityID
+		punishment.CommunityID = communityID
 		stakingPool = stakingPool.Add(stake.Amount)
 		err := k.refundStake(ctx, stake, communityID)
 		if err != nil {
@@ -151,14 +193,15 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 				return punishmentResults, err
 			}
 		}
-		if stake.Expired && stake.Result != nil {
-			punishmentResults, err := k.punishCreatorsWithExpiredStake(ctx, stake, communityID, punishmentResults)
+		if stake.Expired && stake.Result != nil && decided.ClawbackInterest {
+			punishmentResults, err = k.punishCreatorsWithExpiredStake(ctx, stake, communityID, punishmentResults)
 			if err != nil {
 				return punishmentResults, err
 			}
 		}
 		slashMagnitude := int64(k.GetParams(ctx).SlashMagnitude)
-		slashCoin := sdk.NewCoin(app.StakeDenom, stake.Amount.Amount.MulRaw(slashMagnitude))
+		slashAmount := stake.Amount.Amount.MulRaw(slashMagnitude).ToDec().Mul(decided.MagnitudeMultiplier).TruncateInt()
+		slashCoin := sdk.NewCoin(app.StakeDenom, slashAmount)
 		var slashTxType bank.TransactionType
 		switch stake.Type {
 		case staking.StakeUpvote:
@@ -189,23 +232,32 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 			return punishmentResults, ErrInvalidArgument(stake.ArgumentID)
 		}
 
-		if stake.Type == staking.StakeBacking {
+		// withdrawn stakes were already taken out of the claim totals
+		if !stake.Withdrawn && stake.Type == staking.StakeBacking {
 			err = k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
 			if err != nil {
 				return punishmentResults, err
 			}
+			punishment.ClaimStakeRemoved = punishment.ClaimStakeRemoved.Add(stake.Amount)
 		}
-		if stake.Type == staking.StakeChallenge {
+		if !stake.Withdrawn && stake.Type == staking.StakeChallenge {
 			err = k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
 			if err != nil {
 				return punishmentResults, err
 			}
+			punishment.ClaimStakeRemoved = punishment.ClaimStakeRemoved.Add(stake.Amount)
 		}
 
-		// increment slash count for user (and jail if needed)
-		jailed, err := k.accountKeeper.IncrementSlashCount(ctx, stake.Creator)
-		if err != nil {
-			return punishmentResults, err
+		// increment slash count for user by the jail weight (and jail if needed),
+		// every increment is recorded so an appeal can undo it
+		jailed := false
+		for i := 0; i < decided.JailWeight; i++ {
+			jailedNow, err := k.accountKeeper.IncrementSlashCount(ctx, stake.Creator, punishment.SlashID, decided.Reason.String())
+			if err != nil {
+				return punishmentResults, err
+			}
+			jailed = jailed || jailedNow
+			punishment.SlashedCreators = append(punishment.SlashedCreators, stake.Creator)
 		}
 
 		k.Logger(ctx).Info(fmt.Sprintf("jailed: %+v", jailed))
@@ -223,17 +275,12 @@ func (k Keeper) punish(ctx sdk.Context, argumentID uint64) ([]PunishmentResult,
 		return punishmentResults, sdk.ErrInsufficientCoins("staking pool cannot be empty")
 	}
 
-	return k.rewardCurators(ctx, stakingPool, argumentID, communityID, punishmentResults)
+	return k.rewardCurators(ctx, stakingPool, argumentID, communityID, decided.CuratorShare, punishmentResults)
 }
 
 func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.Stake, communityID string, punishmentResults []PunishmentResult) ([]PunishmentResult, sdk.Error) {
 	switch stake.Result.Type {
 	case staking.RewardResultArgumentCreation:
-		// remove argument created interest from earned coins
-		k.stakingKeeper.SubtractEarnedCoin(ctx,
-			stake.Result.ArgumentCreator,
-			communityID,
-			stake.Result.ArgumentCreatorReward.Amount)
 		_, amount, err := k.bankKeeper.SafeSubtractCoin(
 			ctx,
 			stake.Result.ArgumentCreator,
@@ -250,12 +297,13 @@ func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.St
 		if err != nil {
 			return punishmentResults, err
 		}
-	case staking.RewardResultUpvoteSplit:
-		// remove agree received interest from earned coins
+		// remove argument created interest from earned coins,
+		// only what was actually taken back so earnings match the transactions
 		k.stakingKeeper.SubtractEarnedCoin(ctx,
 			stake.Result.ArgumentCreator,
 			communityID,
-			stake.Result.ArgumentCreatorReward.Amount)
+			amount.Amount)
+	case staking.RewardResultUpvoteSplit:
 		_, amount, err := k.bankKeeper.SafeSubtractCoin(
 			ctx,
 			stake.Result.ArgumentCreator,
@@ -267,16 +315,16 @@ func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.St
 		if err != nil {
 			return punishmentResults, err
 		}
+		// remove agree received interest from earned coins
+		k.stakingKeeper.SubtractEarnedCoin(ctx,
+			stake.Result.ArgumentCreator,
+			communityID,
+			amount.Amount)
 		punishmentResults = append(punishmentResults,
 			PunishmentResult{Type: PunishmentInterestSlashed,
 				AppAccAddress: stake.Result.ArgumentCreator,
 				Coin:          amount,
 			})
-		// remove agree given interest from earned coins
-		k.stakingKeeper.SubtractEarnedCoin(ctx,
-			stake.Result.StakeCreator,
-			communityID,
-			stake.Result.StakeCreatorReward.Amount)
 		_, amount, err = k.bankKeeper.SafeSubtractCoin(
 			ctx,
 			stake.Result.StakeCreator,
@@ -289,6 +337,11 @@ func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.St
 		if err != nil {
 			return punishmentResults, err
 		}
+		// remove agree given interest from earned coins
+		k.stakingKeeper.SubtractEarnedCoin(ctx,
+			stake.Result.StakeCreator,
+			communityID,
+			amount.Amount)
 		punishmentResults = append(punishmentResults,
 			PunishmentResult{Type: PunishmentInterestSlashed,
 				AppAccAddress: stake.Result.StakeCreator,
@@ -300,8 +353,7 @@ func (k Keeper) punishCreatorsWithExpiredStake(ctx sdk.Context, stake staking.St
 }
 
 // reward curators who marked "unhelpful"
-func (k Keeper) rewardCurators(ctx sdk.Context, stakingPool sdk.Coin, argumentID uint64, communityID string, punishmentResults []PunishmentResult) ([]PunishmentResult, sdk.Error) {
-	curatorShareDec := k.GetParams(ctx).CuratorShare
+func (k Keeper) rewardCurators(ctx sdk.Context, stakingPool sdk.Coin, argumentID uint64, communityID string, curatorShareDec sdk.Dec, punishmentResults []PunishmentResult) ([]PunishmentResult, sdk.Error) {
 	totalCuratorAmountDec := stakingPool.Amount.ToDec().Mul(curatorShareDec)
 
 	slashes := k.ArgumentSlashes(ctx, argumentID)
@@ -470,7 +522,7 @@ func (k Keeper) validateParams(ctx sdk.Context, argumentID uint64, detailedReaso
 		return ErrInvalidArgument(argumentID)
 	}
 
-	if k.getSlashCount(ctx, argumentID) >= params.MinSlashCount {
+	if params.QuorumMode != QuorumStakeWeighted && k.getSlashCount(ctx, argumentID) >= params.MinSlashCount {
 		return ErrMaxSlashCountReached(argumentID)
 	}
 
@@ -492,6 +544,21 @@ func (k Keeper) validateParams(ctx sdk.Context, argumentID uint64, detailedReaso
 	return nil
 }
 
+// quorumReached tells whether the slashes on an argument are enough to punish it
+func (k Keeper) quorumReached(ctx sdk.Context, argumentID uint64) bool {
+	params := k.GetParams(ctx)
+	switch params.QuorumMode {
+	case QuorumStakeWeighted:
+		weight := sdk.ZeroInt()
+		for _, slash := range k.ArgumentSlashes(ctx, argumentID) {
+			weight = weight.Add(slash.Weight)
+		}
+		return weight.GTE(params.StakeWeightedQuorum)
+	default:
+		return k.getSlashCount(ctx, argumentID) >= params.MinSlashCount
+	}
+}
+
 func (k Keeper) hasEnoughEarnedStake(ctx sdk.Context, address sdk.AccAddress, requirement sdk.Coin) bool {
 	totalStakeEarned := k.stakingKeeper.TotalEarnedCoins(ctx, address)
 
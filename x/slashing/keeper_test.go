@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ahmedaly113/ahchain/x/staking"
 
@@ -84,6 +85,7 @@ func TestSlash_Success(t *testing.T) {
 	returnedSlash, err := keeper.Slash(ctx, createdSlash.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, createdSlash, returnedSlash)
+	assert.Equal(t, uint64(1), returnedSlash.ArgumentVersion)
 }
 
 func TestSlash_ErrNotFound(t *testing.T) {
@@ -143,6 +145,53 @@ func TestSlashes_Success(t *testing.T) {
 	assert.True(t, a.IsUnhelpful)
 }
 
+func TestSlashes_StakeWeightedQuorum(t *testing.T) {
+	ctx, keeper := mockDB()
+	_, publicKey1, addr1, coins1 := getFakeAppAccountParams()
+	_, publicKey2, addr2, coins2 := getFakeAppAccountParams()
+	_, publicKey3, addr3, coins3 := getFakeAppAccountParams()
+	_, err := keeper.accountKeeper.CreateAppAccount(ctx, addr1, coins1, publicKey1)
+	assert.NoError(t, err)
+	_, err = keeper.accountKeeper.CreateAppAccount(ctx, addr2, coins2, publicKey2)
+	assert.NoError(t, err)
+	_, err = keeper.accountKeeper.CreateAppAccount(ctx, addr3, coins3, publicKey3)
+	assert.NoError(t, err)
+	genesis := staking.DefaultGenesisState()
+	genesis.UsersEarnings = []staking.UserEarnedCoins{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("furry", 60*app.Shanev))},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("furry", 50*app.Shanev))},
+		// earned in another community, carries no weight on furry arguments
+		{Address: addr3, Coins: sdk.NewCoins(sdk.NewInt64Coin("general", 200*app.Shanev))},
+	}
+	staking.InitGenesis(ctx, keeper.stakingKeeper, genesis)
+
+	p := keeper.GetParams(ctx)
+	p.MinSlashCount = 2
+	p.QuorumMode = QuorumStakeWeighted
+	p.StakeWeightedQuorum = sdk.NewInt(100 * app.Shanev)
+	keeper.SetParams(ctx, p)
+
+	staker := keeper.GetParams(ctx).SlashAdmins[1]
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg1", "summary1", staker, 1, staking.StakeBacking)
+	assert.NoError(t, err)
+
+	slash, _, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", addr3)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.ZeroInt(), slash.Weight)
+	slash, _, err = keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", addr1)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(60*app.Shanev), slash.Weight)
+	// two slashes would have been enough by count
+	a, _ := keeper.stakingKeeper.Argument(ctx, argument.ID)
+	assert.False(t, a.IsUnhelpful)
+
+	_, results, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", addr2)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, results)
+	a, _ = keeper.stakingKeeper.Argument(ctx, argument.ID)
+	assert.True(t, a.IsUnhelpful)
+}
+
 func Test_punishment(t *testing.T) {
 	ctx, keeper := mockDB()
 	staker := keeper.GetParams(ctx).SlashAdmins[0]
@@ -190,6 +239,268 @@ func Test_punishment(t *testing.T) {
 
 	claim, _ = keeper.claimKeeper.Claim(ctx, 1)
 	assert.Equal(t, "0utru", claim.TotalChallenged.String())
+
+	msg, broken := UnhelpfulArgumentsInvariant(keeper)(ctx)
+	assert.False(t, broken, msg)
+	msg, broken = staking.AllInvariants(keeper.stakingKeeper)(ctx)
+	assert.False(t, broken, msg)
+}
+
+func TestPunishment_ReasonAggregation(t *testing.T) {
+	tests := []struct {
+		aggregation string
+		reason      SlashReason
+		slashed     sdk.Int
+		jailed      bool
+	}{
+		// 50 staked * SlashMagnitude 3 * 0.5
+		{ReasonMajority, SlashNoOriginalThought, sdk.NewInt(75 * app.Shanev), false},
+		// 50 staked * SlashMagnitude 3 * 2, jail weight 3 reaches MaxSlashCount
+		{ReasonMostSevere, SlashReasonSpam, sdk.NewInt(300 * app.Shanev), true},
+	}
+	for _, test := range tests {
+		ctx, keeper := mockDB()
+		_, publicKey1, addr1, coins1 := getFakeAppAccountParams()
+		_, publicKey2, addr2, coins2 := getFakeAppAccountParams()
+		_, publicKey3, addr3, coins3 := getFakeAppAccountParams()
+		_, err := keeper.accountKeeper.CreateAppAccount(ctx, addr1, coins1, publicKey1)
+		assert.NoError(t, err)
+		_, err = keeper.accountKeeper.CreateAppAccount(ctx, addr2, coins2, publicKey2)
+		assert.NoError(t, err)
+		_, err = keeper.accountKeeper.CreateAppAccount(ctx, addr3, coins3, publicKey3)
+		assert.NoError(t, err)
+		genesis := staking.DefaultGenesisState()
+		genesis.UsersEarnings = []staking.UserEarnedCoins{
+			{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("furry", 60*app.Shanev))},
+			{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("furry", 60*app.Shanev))},
+			{Address: addr3, Coins: sdk.NewCoins(sdk.NewInt64Coin("furry", 60*app.Shanev))},
+		}
+		staking.InitGenesis(ctx, keeper.stakingKeeper, genesis)
+
+		p := keeper.GetParams(ctx)
+		p.MinSlashCount = 3
+		p.ReasonAggregation = test.aggregation
+		keeper.SetParams(ctx, p)
+
+		staker := keeper.GetParams(ctx).SlashAdmins[0]
+		argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+		assert.NoError(t, err)
+
+		_, _, err = keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonSpam, "", addr1)
+		assert.NoError(t, err)
+		_, _, err = keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashNoOriginalThought, "", addr2)
+		assert.NoError(t, err)
+		_, results, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashNoOriginalThought, "", addr3)
+		assert.NoError(t, err)
+
+		assert.NotEmpty(t, results)
+		for _, result := range results {
+			assert.Equal(t, test.reason, result.Reason)
+			if result.Type == PunishmentStakeSlashed {
+				assert.Equal(t, test.slashed, result.Coin.Amount)
+			}
+		}
+		punishment, ok := keeper.Punishment(ctx, argument.ID)
+		assert.True(t, ok)
+		assert.Equal(t, test.reason, punishment.Reason)
+		jailWeight := keeper.GetParams(ctx).ReasonPunishment(test.reason).JailWeight
+		assert.Len(t, punishment.SlashedCreators, jailWeight)
+		jailed, err := keeper.accountKeeper.IsJailed(ctx, staker)
+		assert.NoError(t, err)
+		assert.Equal(t, test.jailed, jailed)
+
+		msg, broken := staking.AllInvariants(keeper.stakingKeeper)(ctx)
+		assert.False(t, broken, msg)
+	}
+}
+
+func TestAppealSlash_Upheld(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+	accountParams := keeper.accountKeeper.GetParams(ctx)
+	accountParams.MaxSlashCount = 1
+	keeper.accountKeeper.SetParams(ctx, accountParams)
+	stakerStartingBalance := keeper.bankKeeper.GetCoins(ctx, staker)
+	slasherStartingBalance := keeper.bankKeeper.GetCoins(ctx, slasher)
+
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	claimBefore, ok := keeper.claimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	slash, _, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+	punishment, ok := keeper.Punishment(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, slash.ID, punishment.SlashID)
+	assert.True(t, argument.TotalStake.IsEqual(punishment.ClaimStakeRemoved))
+	assert.Equal(t, []sdk.AccAddress{staker}, punishment.SlashedCreators)
+	jailed, err := keeper.accountKeeper.IsJailed(ctx, staker)
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+
+	appeal, err := keeper.AppealSlash(ctx, slash.ID, "it is my own work", staker)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealPending, appeal.Status)
+	assert.Equal(t, argument.ID, appeal.ArgumentID)
+
+	appeal, results, err := keeper.ResolveAppeal(ctx, appeal.ID, true, slasher)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealUpheld, appeal.Status)
+	assert.Equal(t, slasher, appeal.Resolver)
+	assert.Len(t, results, 3)
+
+	// staker and slasher are back to where they started
+	assert.Equal(t, stakerStartingBalance.String(), keeper.bankKeeper.GetCoins(ctx, staker).String())
+	assert.Equal(t, slasherStartingBalance.String(), keeper.bankKeeper.GetCoins(ctx, slasher).String())
+	jailed, err = keeper.accountKeeper.IsJailed(ctx, staker)
+	assert.NoError(t, err)
+	assert.False(t, jailed)
+	account, err := keeper.accountKeeper.PrimaryAccount(ctx, staker)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, account.SlashCount)
+	reverted, ok := keeper.stakingKeeper.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.False(t, reverted.IsUnhelpful)
+	claimAfter, ok := keeper.claimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.True(t, claimBefore.TotalChallenged.IsEqual(claimAfter.TotalChallenged))
+	assert.True(t, claimBefore.TotalBacked.IsEqual(claimAfter.TotalBacked))
+
+	_, _, err = keeper.ResolveAppeal(ctx, appeal.ID, true, slasher)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAppealResolved, err.Code())
+
+	// the reverted slash no longer counts against the argument
+	slash, err = keeper.Slash(ctx, slash.ID)
+	assert.NoError(t, err)
+	assert.True(t, slash.Reverted)
+	assert.Len(t, keeper.ArgumentSlashes(ctx, argument.ID), 0)
+	_, ok = keeper.Punishment(ctx, argument.ID)
+	assert.False(t, ok)
+	_, ok = keeper.ArgumentAppeal(ctx, argument.ID)
+	assert.False(t, ok)
+
+	// the argument can be slashed and the new punishment appealed again
+	second, _, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+	punishment, ok = keeper.Punishment(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, second.ID, punishment.SlashID)
+	_, err = keeper.AppealSlash(ctx, second.ID, "still my own work", staker)
+	assert.NoError(t, err)
+
+	msg, broken := UnhelpfulArgumentsInvariant(keeper)(ctx)
+	assert.False(t, broken, msg)
+	msg, broken = staking.AllInvariants(keeper.stakingKeeper)(ctx)
+	assert.False(t, broken, msg)
+}
+
+func TestAppealSlash_UpheldKeepsLaterJail(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+	accountParams := keeper.accountKeeper.GetParams(ctx)
+	accountParams.MaxSlashCount = 1
+	keeper.accountKeeper.SetParams(ctx, accountParams)
+
+	first, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	second, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg3", "summary3", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+
+	slash, _, err := keeper.CreateSlash(ctx, first.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+	err = keeper.accountKeeper.UnJail(ctx, staker)
+	assert.NoError(t, err)
+	// jailed again by a different slash
+	_, _, err = keeper.CreateSlash(ctx, second.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	appeal, err := keeper.AppealSlash(ctx, slash.ID, "it is my own work", staker)
+	assert.NoError(t, err)
+	_, _, err = keeper.ResolveAppeal(ctx, appeal.ID, true, slasher)
+	assert.NoError(t, err)
+
+	// the appeal only overturns the first slash, the current jail comes from the second
+	jailed, err := keeper.accountKeeper.IsJailed(ctx, staker)
+	assert.NoError(t, err)
+	assert.True(t, jailed)
+}
+
+func TestAppealSlash_Rejected(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	slash, _, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+	stakerBalance := keeper.bankKeeper.GetCoins(ctx, staker)
+
+	// only the argument creator can appeal
+	_, err = keeper.AppealSlash(ctx, slash.ID, "not mine", slasher)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	appeal, err := keeper.AppealSlash(ctx, slash.ID, "it is my own work", staker)
+	assert.NoError(t, err)
+	_, err = keeper.AppealSlash(ctx, slash.ID, "again", staker)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAppealExists, err.Code())
+
+	// only slash admins resolve appeals
+	_, _, err = keeper.ResolveAppeal(ctx, appeal.ID, false, sdk.AccAddress([]byte{1, 2}))
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAddressNotAuthorised, err.Code())
+
+	appeal, results, err := keeper.ResolveAppeal(ctx, appeal.ID, false, slasher)
+	assert.NoError(t, err)
+	assert.Equal(t, AppealRejected, appeal.Status)
+	assert.Len(t, results, 0)
+	assert.Equal(t, stakerBalance.String(), keeper.bankKeeper.GetCoins(ctx, staker).String())
+}
+
+func TestAppealSlash_WindowClosed(t *testing.T) {
+	ctx, keeper := mockDB()
+	staker := keeper.GetParams(ctx).SlashAdmins[0]
+	slasher := keeper.GetParams(ctx).SlashAdmins[1]
+
+	argument, err := keeper.stakingKeeper.SubmitArgument(ctx, "arg2", "summary2", staker, 1, staking.StakeChallenge)
+	assert.NoError(t, err)
+	slash, _, err := keeper.CreateSlash(ctx, argument.ID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", slasher)
+	assert.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetParams(ctx).AppealWindow + time.Second))
+	_, err = keeper.AppealSlash(ctx, slash.ID, "too late", staker)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAppealWindowClosed, err.Code())
+}
+
+func TestUpdateParams_Invalid(t *testing.T) {
+	ctx, keeper := mockDB()
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+
+	updates := DefaultParams()
+	updates.CuratorShare = sdk.NewDecWithPrec(15, 1)
+	err := keeper.UpdateParams(ctx, admin, updates, []string{"curator_share"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+
+	updates = DefaultParams()
+	updates.ReasonPunishments[0].MagnitudeMultiplier = sdk.NewDec(-1)
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"reason_punishments"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+
+	updates.QuorumMode = "unknown"
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"quorum_mode"})
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+	assert.Equal(t, QuorumCount, keeper.GetParams(ctx).QuorumMode)
+
+	updates.CuratorShare = sdk.NewDecWithPrec(5, 1)
+	err = keeper.UpdateParams(ctx, admin, updates, []string{"curator_share"})
+	assert.NoError(t, err)
+	assert.True(t, sdk.NewDecWithPrec(5, 1).Equal(keeper.GetParams(ctx).CuratorShare))
 }
 
 func TestAddAdmin_Success(t *testing.T) {
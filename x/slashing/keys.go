@@ -12,18 +12,26 @@ import (
 // - 0x00<slashID>: Slash{}
 // - 0x01: nextSlashID
 // - 0x02<argumentID>: slashCount
+// - 0x03<argumentID>: Punishment{}
+// - 0x04<appealID>: Appeal{}
+// - 0x05: nextAppealID
 //
 // - 0x10<creator><slashID>: slashID
 // - 0x11<argumentID><slashID>: slashID
 // - 0x12<argumentID><slashCreator><slashID>: slashID
+// - 0x13<argumentID>: appealID
 var (
-	SlashesKeyPrefix = []byte{0x00}
-	SlashIDKey       = []byte{0x01}
-	SlashCountPrefix = []byte{0x02}
+	SlashesKeyPrefix     = []byte{0x00}
+	SlashIDKey           = []byte{0x01}
+	SlashCountPrefix     = []byte{0x02}
+	PunishmentsKeyPrefix = []byte{0x03}
+	AppealsKeyPrefix     = []byte{0x04}
+	AppealIDKey          = []byte{0x05}
 
 	CreatorSlashesPrefix  = []byte{0x10}
 	ArgumentSlashesPrefix = []byte{0x11}
 	ArgumentCreatorPrefix = []byte{0x12}
+	ArgumentAppealPrefix  = []byte{0x13}
 )
 
 // key for getting a specific slash from the store
@@ -67,3 +75,15 @@ func argumentSlasherPrefix(argumentID uint64, slasher sdk.AccAddress) []byte {
 func argumentSlasherSlashKey(argumentID uint64, slasher sdk.AccAddress, slashID uint64) []byte {
 	return append(argumentSlasherPrefix(argumentID, slasher), sdk.Uint64ToBigEndian(slashID)...)
 }
+
+func punishmentKey(argumentID uint64) []byte {
+	return append(PunishmentsKeyPrefix, sdk.Uint64ToBigEndian(argumentID)...)
+}
+
+func appealKey(appealID uint64) []byte {
+	return append(AppealsKeyPrefix, sdk.Uint64ToBigEndian(appealID)...)
+}
+
+func argumentAppealKey(argumentID uint64) []byte {
+	return append(ArgumentAppealPrefix, sdk.Uint64ToBigEndian(argumentID)...)
+}
@@ -81,6 +81,7 @@ func NewAppModule(keeper Keeper) AppModule {
 
 // RegisterInvariants enforces registering of invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
 }
 
 // Route defines the key for the route
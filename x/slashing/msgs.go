@@ -15,6 +15,10 @@ const (
 	TypeMsgRemoveAdmin = "remove_admin"
 	// TypeMsgUpdateParams represents the type of
 	TypeMsgUpdateParams = "update_params"
+	// TypeMsgAppealSlash represents the type of message for appealing the punishment of an argument
+	TypeMsgAppealSlash = "appeal_slash"
+	// TypeMsgResolveAppeal represents the type of message for resolving an appeal
+	TypeMsgResolveAppeal = "resolve_appeal"
 )
 
 // MsgSlashArgument defines the message to slash an argument
@@ -192,3 +196,91 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Updater)}
 }
+
+// MsgAppealSlash defines the message to appeal the punishment of an argument
+type MsgAppealSlash struct {
+	SlashID uint64         `json:"slash_id"`
+	Reason  string         `json:"reason"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgAppealSlash returns the message to appeal the punishment of an argument
+func NewMsgAppealSlash(slashID uint64, reason string, creator sdk.AccAddress) MsgAppealSlash {
+	return MsgAppealSlash{
+		SlashID: slashID,
+		Reason:  reason,
+		Creator: creator,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAppealSlash) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Creator.String()))
+	}
+
+	if len(msg.Reason) == 0 {
+		return ErrInvalidSlashReason("Need to have a reason to appeal")
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgAppealSlash) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgAppealSlash) Type() string { return TypeMsgAppealSlash }
+
+// GetSignBytes implements Msg
+func (msg MsgAppealSlash) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the creator as the signer.
+func (msg MsgAppealSlash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// MsgResolveAppeal defines the message to uphold or reject an appeal
+type MsgResolveAppeal struct {
+	AppealID uint64         `json:"appeal_id"`
+	Upheld   bool           `json:"upheld"`
+	Resolver sdk.AccAddress `json:"resolver"`
+}
+
+// NewMsgResolveAppeal returns the message to uphold or reject an appeal
+func NewMsgResolveAppeal(appealID uint64, upheld bool, resolver sdk.AccAddress) MsgResolveAppeal {
+	return MsgResolveAppeal{
+		AppealID: appealID,
+		Upheld:   upheld,
+		Resolver: resolver,
+	}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgResolveAppeal) ValidateBasic() sdk.Error {
+	if len(msg.Resolver) == 0 {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Invalid address: %s", msg.Resolver.String()))
+	}
+
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgResolveAppeal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgResolveAppeal) Type() string { return TypeMsgResolveAppeal }
+
+// GetSignBytes implements Msg
+func (msg MsgResolveAppeal) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners implements Msg. Returns the resolver as the signer.
+func (msg MsgResolveAppeal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Resolver)}
+}
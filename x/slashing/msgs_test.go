@@ -89,3 +89,25 @@ func TestMsgRemoveAdmin_InvalidAdmin(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
 }
+
+func TestMsgAppealSlash_Success(t *testing.T) {
+	msg := NewMsgAppealSlash(1, "it is my own work", sdk.AccAddress([]byte{1, 2}))
+	err := msg.ValidateBasic()
+	assert.Nil(t, err)
+	assert.Equal(t, ModuleName, msg.Route())
+	assert.Equal(t, TypeMsgAppealSlash, msg.Type())
+}
+
+func TestMsgAppealSlash_MissingReason(t *testing.T) {
+	msg := NewMsgAppealSlash(1, "", sdk.AccAddress([]byte{1, 2}))
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, ErrInvalidSlashReason("").Code(), err.Code())
+}
+
+func TestMsgResolveAppeal_InvalidResolver(t *testing.T) {
+	msg := NewMsgResolveAppeal(1, true, sdk.AccAddress(nil))
+	err := msg.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.ErrInvalidAddress("").Code(), err.Code())
+}
@@ -3,6 +3,7 @@ package slashing
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,16 +18,35 @@ var (
 	KeySlashAdmins             = []byte("slashAdmins")
 	KeyCuratorShare            = []byte("curatorShare")
 	KeyMaxDetailedReasonLength = []byte("maxDetailedReasonLength")
+	KeyAppealWindow            = []byte("appealWindow")
+	KeyQuorumMode              = []byte("quorumMode")
+	KeyStakeWeightedQuorum     = []byte("stakeWeightedQuorum")
+	KeyReasonPunishments       = []byte("reasonPunishments")
+	KeyReasonAggregation       = []byte("reasonAggregation")
+)
+
+// Quorum modes that decide when slashes on an argument trigger its punishment
+const (
+	// QuorumCount punishes once MinSlashCount curators slashed the argument
+	QuorumCount = "count"
+	// QuorumStakeWeighted punishes once the summed weight of the slashes reaches StakeWeightedQuorum,
+	// each slash weighs the coins its curator earned in the community of the argument
+	QuorumStakeWeighted = "stake_weighted"
 )
 
 // Params holds parameters for Slashing
 type Params struct {
-	MinSlashCount           int              `json:"min_slash_count"`
-	SlashMagnitude          int              `json:"slash_magnitude"`
-	SlashMinStake           sdk.Coin         `json:"slash_min_stake"`
-	SlashAdmins             []sdk.AccAddress `json:"slash_admins"`
-	CuratorShare            sdk.Dec          `json:"curator_share"`
-	MaxDetailedReasonLength int              `json:"max_detailed_reason_length"`
+	MinSlashCount           int                `json:"min_slash_count"`
+	SlashMagnitude          int                `json:"slash_magnitude"`
+	SlashMinStake           sdk.Coin           `json:"slash_min_stake"`
+	SlashAdmins             []sdk.AccAddress   `json:"slash_admins"`
+	CuratorShare            sdk.Dec            `json:"curator_share"`
+	MaxDetailedReasonLength int                `json:"max_detailed_reason_length"`
+	AppealWindow            time.Duration      `json:"appeal_window"`
+	QuorumMode              string             `json:"quorum_mode"`
+	StakeWeightedQuorum     sdk.Int            `json:"stake_weighted_quorum"`
+	ReasonPunishments       []ReasonPunishment `json:"reason_punishments"`
+	ReasonAggregation       string             `json:"reason_aggregation"`
 }
 
 // DefaultParams is the Slashing params for testing
@@ -39,6 +59,11 @@ func DefaultParams() Params {
 		SlashAdmins:             []sdk.AccAddress{},
 		CuratorShare:            sdk.NewDecWithPrec(25, 2),
 		MaxDetailedReasonLength: 140,
+		AppealWindow:            time.Hour * 24 * 3,
+		QuorumMode:              QuorumCount,
+		StakeWeightedQuorum:     sdk.NewInt(100 * app.Shanev),
+		ReasonPunishments:       DefaultReasonPunishments(),
+		ReasonAggregation:       ReasonMajority,
 	}
 }
 
@@ -51,6 +76,11 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeySlashAdmins, Value: &p.SlashAdmins},
 		{Key: KeyCuratorShare, Value: &p.CuratorShare},
 		{Key: KeyMaxDetailedReasonLength, Value: &p.MaxDetailedReasonLength},
+		{Key: KeyAppealWindow, Value: &p.AppealWindow},
+		{Key: KeyQuorumMode, Value: &p.QuorumMode},
+		{Key: KeyStakeWeightedQuorum, Value: &p.StakeWeightedQuorum},
+		{Key: KeyReasonPunishments, Value: &p.ReasonPunishments},
+		{Key: KeyReasonAggregation, Value: &p.ReasonAggregation},
 	}
 }
 
@@ -82,11 +112,77 @@ func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Pa
 
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := validateParams(updated); err != nil {
+		return ErrInvalidParams(err)
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
 }
 
+// validateParams checks the params of a genesis or an update
+func validateParams(p Params) error {
+	if p.MinSlashCount < 1 {
+		return fmt.Errorf("Param: MinSlashCount, must have a positive value")
+	}
+
+	if p.SlashMagnitude < 1 {
+		return fmt.Errorf("Param: SlashMagnitude, must have a positive value")
+	}
+
+	if p.SlashMinStake.IsNegative() {
+		return fmt.Errorf("Param: SlashMinStake, cannot be a negative value")
+	}
+
+	if len(p.SlashAdmins) < 1 {
+		return fmt.Errorf("Param: SlashAdmins, must have atleast one admin")
+	}
+
+	if p.CuratorShare.IsNil() || p.CuratorShare.IsNegative() || p.CuratorShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("Param: CuratorShare, must be between 0 and 1")
+	}
+
+	if p.AppealWindow <= 0 {
+		return fmt.Errorf("Param: AppealWindow, must have a positive value")
+	}
+
+	switch p.QuorumMode {
+	case QuorumCount:
+	case QuorumStakeWeighted:
+		if !p.StakeWeightedQuorum.IsPositive() {
+			return fmt.Errorf("Param: StakeWeightedQuorum, must have a positive value")
+		}
+	default:
+		return fmt.Errorf("Param: QuorumMode, must be %s or %s", QuorumCount, QuorumStakeWeighted)
+	}
+
+	if p.ReasonAggregation != ReasonMajority && p.ReasonAggregation != ReasonMostSevere {
+		return fmt.Errorf("Param: ReasonAggregation, must be %s or %s", ReasonMajority, ReasonMostSevere)
+	}
+
+	reasons := make(map[SlashReason]bool)
+	for _, punishment := range p.ReasonPunishments {
+		if punishment.Reason < 0 || int(punishment.Reason) >= len(SlashReasonName) {
+			return fmt.Errorf("Param: ReasonPunishments, unknown reason %d", punishment.Reason)
+		}
+		if reasons[punishment.Reason] {
+			return fmt.Errorf("Param: ReasonPunishments, duplicate reason %s", punishment.Reason)
+		}
+		reasons[punishment.Reason] = true
+		if punishment.MagnitudeMultiplier.IsNil() || punishment.MagnitudeMultiplier.IsNegative() {
+			return fmt.Errorf("Param: ReasonPunishments, %s magnitude multiplier cannot be a negative value", punishment.Reason)
+		}
+		if punishment.JailWeight < 0 {
+			return fmt.Errorf("Param: ReasonPunishments, %s jail weight cannot be a negative value", punishment.Reason)
+		}
+		if punishment.CuratorShare.IsNil() || punishment.CuratorShare.IsNegative() || punishment.CuratorShare.GT(sdk.OneDec()) {
+			return fmt.Errorf("Param: ReasonPunishments, %s curator share must be between 0 and 1", punishment.Reason)
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) getUpdatedParams(current Params, updates Params, updatedFields []string) Params {
 	updated := current
 	mapParams(updates, func(param string, index int, field reflect.StructField) {
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	app "github.com/ahmedaly113/ahchain/types"
 )
 
 // query endpoints supported by the ahchain Querier
@@ -14,6 +16,9 @@ const (
 	QueryArgumentSlashes        = "argument_slashes"
 	QueryArgumentSlasherSlashes = "argument_slasher_slashes"
 	QueryParams                 = "params"
+	QueryAppeal                 = "appeal"
+	QueryArgumentAppeal         = "argument_appeal"
+	QueryAccountExport          = "account_export"
 )
 
 // QuerySlashParams are params for querying slashes by id queries
@@ -21,9 +26,21 @@ type QuerySlashParams struct {
 	ID uint64 `json:"id"`
 }
 
+// QuerySlashesParams are params for paginating over all slashes
+type QuerySlashesParams struct {
+	Pagination app.Pagination `json:"pagination"`
+}
+
 // QueryArgumentSlashesParams are params for querying slashes by argument id
 type QueryArgumentSlashesParams struct {
-	ArgumentID uint64 `json:"argument_id"`
+	ArgumentID uint64         `json:"argument_id"`
+	Pagination app.Pagination `json:"pagination"`
+}
+
+// SlashesPage is returned by slash list queries when pagination is requested
+type SlashesPage struct {
+	Slashes    Slashes `json:"slashes"`
+	NextCursor string  `json:"next_cursor"`
 }
 
 // QueryArgumentSlashesParams are params for querying slashes by argument id and slasher
@@ -32,6 +49,21 @@ type QueryArgumentSlasherSlashesParams struct {
 	Slasher    sdk.AccAddress `json:"slasher"`
 }
 
+// QueryAppealParams are params for querying appeals by id
+type QueryAppealParams struct {
+	ID uint64 `json:"id"`
+}
+
+// QueryArgumentAppealParams are params for querying the appeal filed for an argument
+type QueryArgumentAppealParams struct {
+	ArgumentID uint64 `json:"argument_id"`
+}
+
+// QueryAccountExportParams are params for exporting the history of an account
+type QueryAccountExportParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, request abci.RequestQuery) ([]byte, sdk.Error) {
@@ -39,13 +71,19 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case QuerySlash:
 			return querySlash(ctx, request, keeper)
 		case QuerySlashes:
-			return querySlashes(ctx, keeper)
+			return querySlashes(ctx, request, keeper)
 		case QueryArgumentSlashes:
 			return queryArgumentSlashes(ctx, request, keeper)
 		case QueryArgumentSlasherSlashes:
 			return queryArgumentSlasherSlashes(ctx, request, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryAppeal:
+			return queryAppeal(ctx, request, keeper)
+		case QueryArgumentAppeal:
+			return queryArgumentAppeal(ctx, request, keeper)
+		case QueryAccountExport:
+			return queryAccountExport(ctx, request, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Unknown ahchain query endpoint: slashing/%s", path[0]))
 		}
@@ -69,7 +107,17 @@ func querySlash(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []
 	return bz, nil
 }
 
-func querySlashes(ctx sdk.Context, k Keeper) (result []byte, err sdk.Error) {
+func querySlashes(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QuerySlashesParams{}
+	if len(request.Data) > 0 {
+		if err = unmarshalQueryParams(request, &params); err != nil {
+			return
+		}
+	}
+	if params.Pagination.Paginated() {
+		return querySlashesPage(ctx, k, SlashesKeyPrefix, params.Pagination, true)
+	}
+
 	slashes := k.Slashes(ctx)
 	bz, jsonErr := k.codec.MarshalJSON(slashes)
 	if jsonErr != nil {
@@ -84,6 +132,10 @@ func queryArgumentSlashes(ctx sdk.Context, request abci.RequestQuery, k Keeper)
 		return
 	}
 
+	if params.Pagination.Paginated() {
+		return querySlashesPage(ctx, k, argumentSlashPrefix(params.ArgumentID), params.Pagination, false)
+	}
+
 	slashes := k.ArgumentSlashes(ctx, params.ArgumentID)
 	bz, jsonErr := k.codec.MarshalJSON(slashes)
 	if jsonErr != nil {
@@ -106,6 +158,71 @@ func queryArgumentSlasherSlashes(ctx sdk.Context, request abci.RequestQuery, k K
 	return bz, nil
 }
 
+// querySlashesPage returns a page of the slashes stored under prefix, either
+// as full slashes or as slash ids pointing to them
+func querySlashesPage(ctx sdk.Context, k Keeper, prefix []byte, pagination app.Pagination, stored bool) ([]byte, sdk.Error) {
+	page := SlashesPage{Slashes: make(Slashes, 0)}
+	next, paginateErr := app.PaginateStore(k.store(ctx), prefix, pagination, app.SortAsc, func(value []byte) bool {
+		var slash Slash
+		if stored {
+			k.codec.MustUnmarshalBinaryLengthPrefixed(value, &slash)
+		} else {
+			var slashID uint64
+			k.codec.MustUnmarshalBinaryLengthPrefixed(value, &slashID)
+			s, err := k.Slash(ctx, slashID)
+			if err != nil {
+				return false
+			}
+			slash = s
+		}
+		page.Slashes = append(page.Slashes, slash)
+		return true
+	})
+	if paginateErr != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Incorrectly formatted request data - %s", paginateErr.Error()))
+	}
+	page.NextCursor = next
+	bz, jsonErr := k.codec.MarshalJSON(page)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
+func queryAppeal(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryAppealParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	appeal, err := k.Appeal(ctx, params.ID)
+	if err != nil {
+		return
+	}
+	bz, jsonErr := k.codec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
+func queryArgumentAppeal(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryArgumentAppealParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	appeal, ok := k.ArgumentAppeal(ctx, params.ArgumentID)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("No appeal filed for argument %d", params.ArgumentID))
+	}
+	bz, jsonErr := k.codec.MarshalJSON(appeal)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -117,6 +234,23 @@ func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error)
 	return result, nil
 }
 
+func queryAccountExport(ctx sdk.Context, request abci.RequestQuery, k Keeper) (result []byte, err sdk.Error) {
+	params := QueryAccountExportParams{}
+	if err = unmarshalQueryParams(request, &params); err != nil {
+		return
+	}
+
+	export, err := k.ExportAccount(ctx, params.Address)
+	if err != nil {
+		return
+	}
+	bz, jsonErr := k.codec.MarshalJSON(export)
+	if jsonErr != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", jsonErr.Error()))
+	}
+	return bz, nil
+}
+
 func unmarshalQueryParams(request abci.RequestQuery, params interface{}) (sdkErr sdk.Error) {
 	err := ModuleCodec.UnmarshalJSON(request.Data, params)
 	if err != nil {
@@ -89,7 +89,7 @@ func TestQuerySlashes_Success(t *testing.T) {
 	another, _, err := keeper.CreateSlash(ctx, stakeID, SlashTypeUnhelpful, SlashReasonPlagiarism, "", addr2)
 	assert.Nil(t, err)
 
-	result, sdkErr := querySlashes(ctx, keeper)
+	result, sdkErr := querySlashes(ctx, abci.RequestQuery{}, keeper)
 	assert.Nil(t, sdkErr)
 
 	var all []Slash
@@ -98,6 +98,24 @@ func TestQuerySlashes_Success(t *testing.T) {
 	assert.Len(t, all, 2)
 	assert.Equal(t, all[0], first)
 	assert.Equal(t, all[1], another)
+
+	params := QuerySlashesParams{Pagination: app.Pagination{Limit: 1}}
+	result, sdkErr = querySlashes(ctx, abci.RequestQuery{Data: keeper.codec.MustMarshalJSON(params)}, keeper)
+	assert.Nil(t, sdkErr)
+	var page SlashesPage
+	jsonErr = keeper.codec.UnmarshalJSON(result, &page)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, Slashes{first}, page.Slashes)
+	assert.NotEmpty(t, page.NextCursor)
+
+	params.Pagination.Cursor = page.NextCursor
+	result, sdkErr = querySlashes(ctx, abci.RequestQuery{Data: keeper.codec.MustMarshalJSON(params)}, keeper)
+	assert.Nil(t, sdkErr)
+	page = SlashesPage{}
+	jsonErr = keeper.codec.UnmarshalJSON(result, &page)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, Slashes{another}, page.Slashes)
+	assert.Empty(t, page.NextCursor)
 }
 
 func TestQueryParams_Success(t *testing.T) {
@@ -118,3 +136,31 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQueryAccountExport_Success(t *testing.T) {
+	ctx, keeper := mockDB()
+
+	admin := keeper.GetParams(ctx).SlashAdmins[0]
+	_, _, err := keeper.CreateSlash(ctx, 1, SlashTypeUnhelpful, SlashReasonPlagiarism, "", admin)
+	assert.NoError(t, err)
+	argument, ok := keeper.stakingKeeper.Argument(ctx, 1)
+	assert.True(t, ok)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryAccountExport}, "/"),
+		Data: keeper.codec.MustMarshalJSON(QueryAccountExportParams{Address: argument.Creator}),
+	}
+	result, sdkErr := queryAccountExport(ctx, query, keeper)
+	assert.NoError(t, sdkErr)
+
+	var export AccountExport
+	jsonErr := keeper.codec.UnmarshalJSON(result, &export)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, argument.Creator, export.AppAccount.Identity)
+	assert.Len(t, export.Arguments, 1)
+	assert.NotEmpty(t, export.Stakes)
+	assert.NotEmpty(t, export.Transactions)
+	assert.Len(t, export.SlashesReceived, 1)
+	assert.Len(t, export.Punishments, 1)
+	assert.Len(t, keeper.CreatorSlashes(ctx, admin), 1)
+}
@@ -0,0 +1,122 @@
+package slashing
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reason aggregations that decide which slash reason punishes an argument
+const (
+	// ReasonMajority punishes by the reason most curators gave, ties go to the most severe
+	ReasonMajority = "majority"
+	// ReasonMostSevere punishes by the most severe reason any curator gave
+	ReasonMostSevere = "most_severe"
+)
+
+// ReasonPunishment is the punishment applied to an argument slashed for a reason
+type ReasonPunishment struct {
+	Reason SlashReason `json:"reason"`
+	// MagnitudeMultiplier scales SlashMagnitude
+	MagnitudeMultiplier sdk.Dec `json:"magnitude_multiplier"`
+	// ClawbackInterest takes back the interest earned on expired stakes
+	ClawbackInterest bool `json:"clawback_interest"`
+	// JailWeight is added to the slash count of every punished staker
+	JailWeight int `json:"jail_weight"`
+	// CuratorShare is the share of the stakes given to the curators
+	CuratorShare sdk.Dec `json:"curator_share"`
+}
+
+// DefaultReasonPunishments is the punishment table for reasons that differ
+// from the base SlashMagnitude and CuratorShare params
+func DefaultReasonPunishments() []ReasonPunishment {
+	return []ReasonPunishment{
+		{
+			Reason:              SlashNoOriginalThought,
+			MagnitudeMultiplier: sdk.NewDecWithPrec(5, 1),
+			ClawbackInterest:    false,
+			JailWeight:          1,
+			CuratorShare:        sdk.NewDecWithPrec(25, 2),
+		},
+		{
+			Reason:              SlashReasonOffensiveContent,
+			MagnitudeMultiplier: sdk.NewDecWithPrec(15, 1),
+			ClawbackInterest:    true,
+			JailWeight:          2,
+			CuratorShare:        sdk.NewDecWithPrec(30, 2),
+		},
+		{
+			Reason:              SlashReasonHarassment,
+			MagnitudeMultiplier: sdk.NewDec(2),
+			ClawbackInterest:    true,
+			JailWeight:          3,
+			CuratorShare:        sdk.NewDecWithPrec(35, 2),
+		},
+		{
+			Reason:              SlashReasonSpam,
+			MagnitudeMultiplier: sdk.NewDec(2),
+			ClawbackInterest:    true,
+			JailWeight:          3,
+			CuratorShare:        sdk.NewDecWithPrec(35, 2),
+		},
+	}
+}
+
+// ReasonPunishment returns the punishment for a reason,
+// reasons missing from the table are punished with the base params
+func (p Params) ReasonPunishment(reason SlashReason) ReasonPunishment {
+	for _, punishment := range p.ReasonPunishments {
+		if punishment.Reason == reason {
+			return punishment
+		}
+	}
+	return ReasonPunishment{
+		Reason:              reason,
+		MagnitudeMultiplier: sdk.OneDec(),
+		ClawbackInterest:    true,
+		JailWeight:          1,
+		CuratorShare:        p.CuratorShare,
+	}
+}
+
+// harsherThan tells whether a punishment is more severe than another,
+// by magnitude first and jail weight second
+func (p ReasonPunishment) harsherThan(other ReasonPunishment) bool {
+	if !p.MagnitudeMultiplier.Equal(other.MagnitudeMultiplier) {
+		return p.MagnitudeMultiplier.GT(other.MagnitudeMultiplier)
+	}
+	return p.JailWeight > other.JailWeight
+}
+
+// decidePunishment aggregates the reasons of all slashes on an argument
+// into the punishment applied to it
+func (k Keeper) decidePunishment(ctx sdk.Context, argumentID uint64) ReasonPunishment {
+	params := k.GetParams(ctx)
+	counts := make(map[SlashReason]int)
+	for _, slash := range k.ArgumentSlashes(ctx, argumentID) {
+		counts[slash.Reason]++
+	}
+	reasons := make([]SlashReason, 0, len(counts))
+	for reason := range counts {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	if len(reasons) == 0 {
+		return params.ReasonPunishment(SlashReasonOther)
+	}
+
+	decided := params.ReasonPunishment(reasons[0])
+	for _, reason := range reasons[1:] {
+		punishment := params.ReasonPunishment(reason)
+		if params.ReasonAggregation != ReasonMostSevere && counts[reason] != counts[decided.Reason] {
+			if counts[reason] > counts[decided.Reason] {
+				decided = punishment
+			}
+			continue
+		}
+		if punishment.harsherThan(decided) {
+			decided = punishment
+		}
+	}
+	return decided
+}
@@ -16,17 +16,21 @@ const (
 
 	AttributeKeyMinSlashCountKey = "min-slash-count"
 	AttributeKeySlashResults     = "slash-results"
+	AttributeKeyAppealResults    = "appeal-results"
 )
 
 // Slash stores data about a slashing
 type Slash struct {
-	ID             uint64
-	ArgumentID     uint64
-	Type           SlashType
-	Reason         SlashReason
-	DetailedReason string
-	Creator        sdk.AccAddress
-	CreatedTime    time.Time
+	ID              uint64
+	ArgumentID      uint64
+	ArgumentVersion uint64
+	Type            SlashType
+	Reason          SlashReason
+	DetailedReason  string
+	Creator         sdk.AccAddress
+	Weight          sdk.Int
+	CreatedTime     time.Time
+	Reverted        bool
 }
 
 type PunishmentResultType int
@@ -36,24 +40,82 @@ const (
 	PunishmentStakeSlashed
 	PunishmentCuratorRewarded
 	PunishmentJailed
+	PunishmentStakeRestored
+	PunishmentInterestRestored
+	PunishmentCuratorRewardClawedBack
+	PunishmentUnjailed
 )
 
 type PunishmentResult struct {
 	Type          PunishmentResultType `json:"type"`
 	AppAccAddress sdk.AccAddress       `json:"address"`
 	Coin          sdk.Coin             `json:"coin"`
+	Reason        SlashReason          `json:"reason"`
+}
+
+// Punishment records the outcome of punishing an argument so it can be reverted on appeal
+type Punishment struct {
+	ArgumentID      uint64             `json:"argument_id"`
+	SlashID         uint64             `json:"slash_id"`
+	CommunityID     string             `json:"community_id"`
+	Reason          SlashReason        `json:"reason"`
+	Results         []PunishmentResult `json:"results"`
+	SlashedCreators []sdk.AccAddress   `json:"slashed_creators"`
+	// stake taken out of the claim totals, on the side of the argument
+	ClaimStakeRemoved sdk.Coin  `json:"claim_stake_removed"`
+	CreatedTime       time.Time `json:"created_time"`
 }
 
 // Slashes is an array of slashes
 type Slashes []Slash
 
+// AppealStatus enum
+type AppealStatus int
+
+const (
+	// AppealPending is an appeal waiting for a slash admin
+	AppealPending AppealStatus = iota
+	// AppealUpheld is an appeal that reverted the punishment
+	AppealUpheld
+	// AppealRejected is an appeal that left the punishment in place
+	AppealRejected
+)
+
+// Appeal is filed by an argument creator against the punishment of their argument
+type Appeal struct {
+	ID           uint64
+	ArgumentID   uint64
+	SlashID      uint64
+	Reason       string
+	Creator      sdk.AccAddress
+	Status       AppealStatus
+	Resolver     sdk.AccAddress
+	CreatedTime  time.Time
+	ResolvedTime time.Time
+}
+
+// Appeals is an array of appeals
+type Appeals []Appeal
+
+func (a Appeal) String() string {
+	return fmt.Sprintf(`Appeal %d:
+  ArgumentID: %d
+  SlashID: %d
+  Creator: %s
+  Status: %d
+  CreatedTime: %s`,
+		a.ID, a.ArgumentID, a.SlashID, a.Creator.String(), a.Status, a.CreatedTime.String())
+}
+
 func (s Slash) String() string {
 	return fmt.Sprintf(`Slash %d:
   ArgumentID: %d
+  ArgumentVersion: %d
   Creator: %s
+  Weight: %s
   Reason: %d
   CreatedTime: %s`,
-		s.ID, s.ArgumentID, s.Creator.String(), s.Reason, s.CreatedTime.String())
+		s.ID, s.ArgumentID, s.ArgumentVersion, s.Creator.String(), s.Weight, s.Reason, s.CreatedTime.String())
 }
 
 // SlashType enum
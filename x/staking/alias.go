@@ -2,6 +2,7 @@ package staking
 
 import (
 	"github.com/ahmedaly113/ahchain/x/bank/exported"
+	"github.com/ahmedaly113/ahchain/x/claim"
 	"github.com/ahmedaly113/ahchain/x/distribution"
 )
 
@@ -16,8 +17,12 @@ const (
 	TransactionBackingReturned          = exported.TransactionBackingReturned
 	TransactionChallengeReturned        = exported.TransactionChallengeReturned
 	TransactionUpvoteReturned           = exported.TransactionUpvoteReturned
+	TransactionStakeWinnings            = exported.TransactionStakeWinnings
+	TransactionStakeWithdrawn           = exported.TransactionStakeWithdrawn
 
 	UserRewardPoolName = distribution.UserRewardPoolName
+
+	ClaimOpen = claim.ClaimOpen
 )
 
 type (
@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -13,6 +14,11 @@ func (k Keeper) setClaimArgument(ctx sdk.Context, claimID, argumentID uint64) {
 	k.store(ctx).Set(claimArgumentKey(claimID, argumentID), bz)
 }
 
+// deleteClaimArgument removes a claim <-> argument association from the store
+func (k Keeper) deleteClaimArgument(ctx sdk.Context, claimID, argumentID uint64) {
+	k.store(ctx).Delete(claimArgumentKey(claimID, argumentID))
+}
+
 func (k Keeper) IterateClaimArguments(ctx sdk.Context, claimID uint64, cb func(argument Argument) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), claimArgumentsPrefix(claimID))
 	defer iterator.Close()
@@ -35,6 +41,11 @@ func (k Keeper) setArgumentStake(ctx sdk.Context, argumentID, stakeID uint64) {
 	k.store(ctx).Set(argumentStakeKey(argumentID, stakeID), bz)
 }
 
+// deleteArgumentStake removes a argument <-> stake association from the store
+func (k Keeper) deleteArgumentStake(ctx sdk.Context, argumentID, stakeID uint64) {
+	k.store(ctx).Delete(argumentStakeKey(argumentID, stakeID))
+}
+
 func (k Keeper) IterateArgumentStakes(ctx sdk.Context, argumentID uint64, cb func(stake Stake) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), argumentStakesPrefix(argumentID))
 	defer iterator.Close()
@@ -57,6 +68,11 @@ func (k Keeper) setCommunityStake(ctx sdk.Context, communityID string, stakeID u
 	k.store(ctx).Set(communityStakeKey(communityID, stakeID), bz)
 }
 
+// deleteCommunityStake removes a community <-> stake association from the store
+func (k Keeper) deleteCommunityStake(ctx sdk.Context, communityID string, stakeID uint64) {
+	k.store(ctx).Delete(communityStakeKey(communityID, stakeID))
+}
+
 func (k Keeper) IterateCommunityStakes(ctx sdk.Context, communityID string, cb func(stake Stake) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), communityStakesPrefix(communityID))
 	defer iterator.Close()
@@ -79,6 +95,11 @@ func (k Keeper) setUserArgument(ctx sdk.Context, creator sdk.AccAddress, argumen
 	k.store(ctx).Set(userArgumentKey(creator, argumentID), bz)
 }
 
+// deleteUserArgument removes a user <-> argument association from the store
+func (k Keeper) deleteUserArgument(ctx sdk.Context, creator sdk.AccAddress, argumentID uint64) {
+	k.store(ctx).Delete(userArgumentKey(creator, argumentID))
+}
+
 func (k Keeper) IterateUserArguments(ctx sdk.Context, creator sdk.AccAddress, cb func(argument Argument) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userArgumentsPrefix(creator))
 	defer iterator.Close()
@@ -101,6 +122,11 @@ func (k Keeper) setUserStake(ctx sdk.Context, creator sdk.AccAddress, creationTi
 	k.store(ctx).Set(userStakeKey(creator, creationTime, stakeID), bz)
 }
 
+// deleteUserStake removes a user <-> stake association from the store
+func (k Keeper) deleteUserStake(ctx sdk.Context, creator sdk.AccAddress, creationTime time.Time, stakeID uint64) {
+	k.store(ctx).Delete(userStakeKey(creator, creationTime, stakeID))
+}
+
 func (k Keeper) IterateUserStakes(ctx sdk.Context, creator sdk.AccAddress, cb func(stake Stake) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userStakesPrefix(creator))
 	defer iterator.Close()
@@ -122,6 +148,10 @@ func (k Keeper) setUserCommunityStake(ctx sdk.Context, creator sdk.AccAddress, c
 	k.store(ctx).Set(userCommunityStakeKey(creator, communityID, stakeID), bz)
 }
 
+func (k Keeper) deleteUserCommunityStake(ctx sdk.Context, creator sdk.AccAddress, communityID string, stakeID uint64) {
+	k.store(ctx).Delete(userCommunityStakeKey(creator, communityID, stakeID))
+}
+
 func (k Keeper) IterateUserCommunityStakes(ctx sdk.Context, creator sdk.AccAddress, communityID string, cb func(stake Stake) (stop bool)) {
 	iterator := sdk.KVStorePrefixIterator(k.store(ctx), userCommunityStakesPrefix(creator, communityID))
 	defer iterator.Close()
@@ -164,6 +194,82 @@ func (k Keeper) ActiveStakeQueueIterator(ctx sdk.Context, endTime time.Time) sdk
 	return store.Iterator(ActiveStakeQueuePrefix, sdk.PrefixEndBytes(activeStakeByTimeKey(endTime)))
 }
 
+// activeStakeQueueIteratorFrom returns an sdk.Iterator for the stakes in the Active Queue
+// that expire by endTime, starting at the given queue key
+func (k Keeper) activeStakeQueueIteratorFrom(ctx sdk.Context, start []byte, endTime time.Time) sdk.Iterator {
+	return k.store(ctx).Iterator(start, sdk.PrefixEndBytes(activeStakeByTimeKey(endTime)))
+}
+
+// setFailedExpiringStake records a stake that couldn't be expired along with the reason
+func (k Keeper) setFailedExpiringStake(ctx sdk.Context, stakeID uint64, reason string) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(reason)
+	k.store(ctx).Set(failedExpiringStakeKey(stakeID), bz)
+}
+
+// deleteFailedExpiringStake clears the failure record of a stake
+func (k Keeper) deleteFailedExpiringStake(ctx sdk.Context, stakeID uint64) {
+	k.store(ctx).Delete(failedExpiringStakeKey(stakeID))
+}
+
+// IterateFailedExpiringStakes iterates over the stakes that failed to expire
+func (k Keeper) IterateFailedExpiringStakes(ctx sdk.Context, cb func(stakeID uint64, reason string) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), FailedExpiringStakesPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		stakeID := binary.BigEndian.Uint64(iterator.Key()[len(FailedExpiringStakesPrefix):])
+		var reason string
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &reason)
+		if cb(stakeID, reason) {
+			break
+		}
+	}
+}
+
+// FailedExpiringStakes returns the stakes that failed to expire along with the reason
+func (k Keeper) FailedExpiringStakes(ctx sdk.Context) []ProcessingFailure {
+	failures := make([]ProcessingFailure, 0)
+	k.IterateFailedExpiringStakes(ctx, func(stakeID uint64, reason string) bool {
+		failures = append(failures, ProcessingFailure{ID: stakeID, Reason: reason})
+		return false
+	})
+	return failures
+}
+
+// setFailedClaimResolution records a claim that couldn't be resolved along with the reason
+func (k Keeper) setFailedClaimResolution(ctx sdk.Context, claimID uint64, reason string) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(reason)
+	k.store(ctx).Set(failedClaimResolutionKey(claimID), bz)
+}
+
+// deleteFailedClaimResolution clears the failure record of a claim
+func (k Keeper) deleteFailedClaimResolution(ctx sdk.Context, claimID uint64) {
+	k.store(ctx).Delete(failedClaimResolutionKey(claimID))
+}
+
+// IterateFailedClaimResolutions iterates over the closed claims that failed to resolve
+func (k Keeper) IterateFailedClaimResolutions(ctx sdk.Context, cb func(claimID uint64, reason string) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), FailedClaimResolutionsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		claimID := binary.BigEndian.Uint64(iterator.Key()[len(FailedClaimResolutionsPrefix):])
+		var reason string
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &reason)
+		if cb(claimID, reason) {
+			break
+		}
+	}
+}
+
+// FailedClaimResolutions returns the closed claims that failed to resolve along with the reason
+func (k Keeper) FailedClaimResolutions(ctx sdk.Context) []ProcessingFailure {
+	failures := make([]ProcessingFailure, 0)
+	k.IterateFailedClaimResolutions(ctx, func(claimID uint64, reason string) bool {
+		failures = append(failures, ProcessingFailure{ID: claimID, Reason: reason})
+		return false
+	})
+	return failures
+}
+
 func (k Keeper) IterateActiveStakeQueue(ctx sdk.Context, endTime time.Time, cb func(stake Stake) (stop bool)) {
 	iterator := k.ActiveStakeQueueIterator(ctx, endTime)
 	defer iterator.Close()
@@ -7,6 +7,8 @@ func RegisterCodec(c *codec.Codec) {
 	c.RegisterConcrete(MsgSubmitArgument{}, "ahchain/MsgSubmitArgument", nil)
 	c.RegisterConcrete(MsgSubmitUpvote{}, "ahchain/MsgUpvoteArgument", nil)
 	c.RegisterConcrete(MsgEditArgument{}, "ahchain/MsgEditArgument", nil)
+	c.RegisterConcrete(MsgDeleteArgument{}, "ahchain/MsgDeleteArgument", nil)
+	c.RegisterConcrete(MsgWithdrawStake{}, "ahchain/MsgWithdrawStake", nil)
 	c.RegisterConcrete(MsgAddAdmin{}, "staking/MsgAddAdmin", nil)
 	c.RegisterConcrete(MsgRemoveAdmin{}, "staking/MsgRemoveAdmin", nil)
 	c.RegisterConcrete(MsgUpdateParams{}, "staking/MsgUpdateParams", nil)
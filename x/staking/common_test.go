@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"bytes"
+	"sort"
 	"time"
 
 	"github.com/ahmedaly113/ahchain/x/account"
@@ -8,6 +10,7 @@ import (
 	app "github.com/ahmedaly113/ahchain/types"
 	trubank "github.com/ahmedaly113/ahchain/x/bank"
 	"github.com/ahmedaly113/ahchain/x/claim"
+	"github.com/ahmedaly113/ahchain/x/community"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,17 +28,28 @@ import (
 
 type mockedAccountKeeper struct {
 	jailStatus   map[string]bool
+	jailCount    map[string]int
+	deactivated  map[string]sdk.AccAddress
+	closed       map[string]bool
 	forceFailure bool
 }
 
 func newAccountKeeper() *mockedAccountKeeper {
 	return &mockedAccountKeeper{
-		jailStatus: make(map[string]bool),
+		jailStatus:  make(map[string]bool),
+		jailCount:   make(map[string]int),
+		deactivated: make(map[string]sdk.AccAddress),
+		closed:      make(map[string]bool),
 	}
 }
 
+func (m *mockedAccountKeeper) deactivate(address sdk.AccAddress) {
+	m.deactivated[address.String()] = address
+}
+
 func (m *mockedAccountKeeper) jail(address sdk.AccAddress) {
 	m.jailStatus[address.String()] = true
+	m.jailCount[address.String()]++
 }
 
 func (m *mockedAccountKeeper) fail() {
@@ -53,6 +67,14 @@ func (m *mockedAccountKeeper) IsJailed(ctx sdk.Context, address sdk.AccAddress)
 	return false, nil
 }
 
+func (m *mockedAccountKeeper) CurrentJailEpisode(ctx sdk.Context, address sdk.AccAddress) (int, sdk.Error) {
+	jailed, err := m.IsJailed(ctx, address)
+	if err != nil || !jailed {
+		return 0, err
+	}
+	return m.jailCount[address.String()], nil
+}
+
 func (m *mockedAccountKeeper) UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 	if m.forceFailure {
 		m.forceFailure = false
@@ -62,13 +84,73 @@ func (m *mockedAccountKeeper) UnJail(ctx sdk.Context, address sdk.AccAddress) sd
 	return nil
 }
 
+func (m *mockedAccountKeeper) Identity(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, sdk.Error) {
+	return address, nil
+}
+
+func (m *mockedAccountKeeper) IsDeactivated(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error) {
+	_, ok := m.deactivated[address.String()]
+	return ok, nil
+}
+
+func (m *mockedAccountKeeper) DeactivatedAccountsAfter(ctx sdk.Context, after sdk.AccAddress, limit int) []account.AppAccount {
+	accounts := make([]account.AppAccount, 0)
+	for key, address := range m.deactivated {
+		if !m.closed[key] && bytes.Compare(address, after) > 0 {
+			accounts = append(accounts, account.NewAppAccount(address, ctx.BlockHeader().Time))
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return bytes.Compare(accounts[i].Identity, accounts[j].Identity) < 0 })
+	if len(accounts) > limit {
+		accounts = accounts[:limit]
+	}
+	return accounts
+}
+
+func (m *mockedAccountKeeper) CloseAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	if m.forceFailure {
+		m.forceFailure = false
+		return sdk.ErrInternal("error")
+	}
+	m.closed[address.String()] = true
+	return nil
+}
+
 func (m *mockedAccountKeeper) IterateAppAccounts(ctx sdk.Context, cb func(acc account.AppAccount) (stop bool)) {
 
 }
 
+type mockCommunityKeeper struct {
+	communities map[string]community.Community
+}
+
+func newMockedCommunityKeeper() *mockCommunityKeeper {
+	return &mockCommunityKeeper{
+		communities: make(map[string]community.Community),
+	}
+}
+
+func (m *mockCommunityKeeper) Community(ctx sdk.Context, id string) (community.Community, sdk.Error) {
+	c, ok := m.communities[id]
+	if !ok {
+		return community.Community{}, community.ErrCommunityNotFound(id)
+	}
+	return c, nil
+}
+
+func (m *mockCommunityKeeper) Communities(ctx sdk.Context) []community.Community {
+	communities := make([]community.Community, 0, len(m.communities))
+	for _, c := range m.communities {
+		communities = append(communities, c)
+	}
+	return communities
+}
+
 type mockClaimKeeper struct {
 	claims           map[uint64]claim.Claim
 	enableTrackStake bool
+	// claims whose resolution fails
+	unresolvable map[uint64]bool
 }
 
 func newMockedClaimKeeper() *mockClaimKeeper {
@@ -92,6 +174,19 @@ func (m *mockClaimKeeper) Claim(ctx sdk.Context, id uint64) (claim.Claim, bool)
 	c, ok := m.claims[id]
 	return c, ok
 }
+
+func (m *mockClaimKeeper) GetParams(ctx sdk.Context) claim.Params {
+	return claim.DefaultParams()
+}
+
+func (m *mockClaimKeeper) Claims(ctx sdk.Context) claim.Claims {
+	claims := make(claim.Claims, 0)
+	for _, c := range m.claims {
+		claims = append(claims, c)
+	}
+	return claims
+}
+
 func (m *mockClaimKeeper) AddBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error {
 	if !m.enableTrackStake {
 		return nil
@@ -133,6 +228,9 @@ func (m *mockClaimKeeper) AddChallengeStake(ctx sdk.Context, id uint64, stake sd
 
 // SubtractBackingStake adds a stake amount to the total backing amount
 func (m *mockClaimKeeper) SubtractBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error {
+	if !m.enableTrackStake {
+		return nil
+	}
 	c, ok := m.Claim(ctx, id)
 	if !ok {
 		return sdk.ErrInternal("unknown claim")
@@ -145,6 +243,9 @@ func (m *mockClaimKeeper) SubtractBackingStake(ctx sdk.Context, id uint64, stake
 
 // SubtractChallengeStake adds a stake amount to the total challenge amount
 func (m *mockClaimKeeper) SubtractChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error {
+	if !m.enableTrackStake {
+		return nil
+	}
 	c, ok := m.Claim(ctx, id)
 	if !ok {
 		return sdk.ErrInternal("unknown claim")
@@ -155,12 +256,50 @@ func (m *mockClaimKeeper) SubtractChallengeStake(ctx sdk.Context, id uint64, sta
 	return nil
 }
 
+func (m *mockClaimKeeper) VotingClosedClaims(ctx sdk.Context) claim.Claims {
+	claims := make(claim.Claims, 0)
+	for _, c := range m.claims {
+		if c.Status == claim.ClaimVotingClosed {
+			claims = append(claims, c)
+		}
+	}
+	return claims
+}
+
+func (m *mockClaimKeeper) VotingClosedClaimsFrom(ctx sdk.Context, startID uint64, limit int) claim.Claims {
+	claims := make(claim.Claims, 0)
+	for _, c := range m.VotingClosedClaims(ctx) {
+		if c.ID >= startID {
+			claims = append(claims, c)
+		}
+	}
+	sort.Slice(claims, func(i, j int) bool { return claims[i].ID < claims[j].ID })
+	if len(claims) > limit {
+		claims = claims[:limit]
+	}
+	return claims
+}
+
+func (m *mockClaimKeeper) ResolveClaim(ctx sdk.Context, id uint64) sdk.Error {
+	c, ok := m.Claim(ctx, id)
+	if !ok {
+		return sdk.ErrInternal("unknown claim")
+	}
+	if m.unresolvable[id] {
+		return sdk.ErrInternal("claim can't be resolved")
+	}
+	c.Status = claim.ClaimResolved
+	m.claims[id] = c
+	return nil
+}
+
 type mockedDB struct {
-	authAccKeeper auth.AccountKeeper
-	accountKeeper AccountKeeper
-	claimKeeper   ClaimKeeper
-	bankKeeper    BankKeeper
-	supplyKeeper  supply.Keeper
+	authAccKeeper   auth.AccountKeeper
+	accountKeeper   AccountKeeper
+	claimKeeper     ClaimKeeper
+	communityKeeper *mockCommunityKeeper
+	bankKeeper      BankKeeper
+	supplyKeeper    supply.Keeper
 }
 
 func mockDB() (sdk.Context, Keeper, *mockedDB) {
@@ -237,7 +376,8 @@ func mockDB() (sdk.Context, Keeper, *mockedDB) {
 	mockedAccountKeeper := newAccountKeeper()
 	mockedClaimKeeper := newMockedClaimKeeper()
 	mockedClaimKeeper.claims = make(map[uint64]claim.Claim)
-	keeper := NewKeeper(cdc, storeKey, mockedAccountKeeper, trubankKeeper, mockedClaimKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), DefaultCodespace)
+	mockedCommunityKeeper := newMockedCommunityKeeper()
+	keeper := NewKeeper(cdc, storeKey, mockedAccountKeeper, trubankKeeper, mockedClaimKeeper, mockedCommunityKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), DefaultCodespace)
 	_, _, admin1 := keyPubAddr()
 	_, _, admin2 := keyPubAddr()
 	genesis := DefaultGenesisState()
@@ -246,11 +386,12 @@ func mockDB() (sdk.Context, Keeper, *mockedDB) {
 	trubank.InitGenesis(ctx, trubankKeeper, trubank.DefaultGenesisState())
 
 	mockedDB := &mockedDB{
-		claimKeeper:   mockedClaimKeeper,
-		accountKeeper: mockedAccountKeeper,
-		authAccKeeper: accKeeper,
-		bankKeeper:    trubankKeeper,
-		supplyKeeper:  supplyKeeper,
+		claimKeeper:     mockedClaimKeeper,
+		communityKeeper: mockedCommunityKeeper,
+		accountKeeper:   mockedAccountKeeper,
+		authAccKeeper:   accKeeper,
+		bankKeeper:      trubankKeeper,
+		supplyKeeper:    supplyKeeper,
 	}
 	return ctx, keeper, mockedDB
 }
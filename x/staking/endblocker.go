@@ -1,47 +1,140 @@
 package staking
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndBlocker called every block, process expiring stakes
+// EndBlocker called every block, resolves closed claims and process expiring stakes
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.processClosedClaims(ctx)
 	keeper.processExpiringStakes(ctx)
+	keeper.closeDeactivatedAccounts(ctx)
 }
 
+// closeDeactivatedAccounts closes the deactivated accounts whose stakes all expired.
+// At most MaxAccountClosuresPerBlock accounts are checked per block, the next block
+// resumes after the last one checked. Accounts that fail to close are retried on the next pass.
+func (k Keeper) closeDeactivatedAccounts(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	store := k.store(ctx)
+	limit := k.GetParams(ctx).MaxAccountClosuresPerBlock
+
+	accounts := k.accountKeeper.DeactivatedAccountsAfter(ctx, store.Get(DeactivatedAccountsCursorKey), limit)
+	if len(accounts) < limit {
+		store.Delete(DeactivatedAccountsCursorKey)
+	} else {
+		store.Set(DeactivatedAccountsCursorKey, accounts[len(accounts)-1].Identity)
+	}
+
+	for _, acc := range accounts {
+		active := false
+		k.IterateUserStakes(ctx, acc.Identity, func(stake Stake) bool {
+			active = !stake.Expired
+			return active
+		})
+		if active {
+			continue
+		}
+		err := k.accountKeeper.CloseAccount(ctx, acc.Identity)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Skipping closing account %s: %s", acc.Identity, err.Error()))
+			continue
+		}
+	}
+}
+
+type expiringStakeEntry struct {
+	key     []byte
+	stakeID uint64
+}
+
+// processExpiringStakes expires at most MaxExpiringStakesPerBlock stakes per block.
+// When the cap is hit the last visited queue key is kept as a cursor so the next
+// block resumes from there. Stakes that fail are recorded and left in the queue,
+// they are retried once the cursor wraps around.
 func (k Keeper) processExpiringStakes(ctx sdk.Context) {
 	logger := k.Logger(ctx)
-	expiredStakes := make([]Stake, 0)
-	fmt.Println("processing expired stakes")
-	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time, func(stake Stake) bool {
+	store := k.store(ctx)
+	limit := k.GetParams(ctx).MaxExpiringStakesPerBlock
+
+	start := ActiveStakeQueuePrefix
+	if cursor := store.Get(ActiveStakeQueueCursorKey); cursor != nil {
+		// smallest key strictly after the cursor
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	end := sdk.PrefixEndBytes(activeStakeByTimeKey(ctx.BlockHeader().Time))
+
+	// collect first since processing mutates the queue
+	entries := make([]expiringStakeEntry, 0)
+	exhausted := true
+	if bytes.Compare(start, end) < 0 {
+		iterator := k.activeStakeQueueIteratorFrom(ctx, start, ctx.BlockHeader().Time)
+		for ; iterator.Valid() && len(entries) < limit; iterator.Next() {
+			var stakeID uint64
+			k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stakeID)
+			entries = append(entries, expiringStakeEntry{
+				key:     append([]byte{}, iterator.Key()...),
+				stakeID: stakeID,
+			})
+		}
+		exhausted = !iterator.Valid()
+		iterator.Close()
+	}
+
+	for _, entry := range entries {
+		stake, ok := k.Stake(ctx, entry.stakeID)
+		if !ok {
+			logger.Error(fmt.Sprintf("Dropping unknown stakeID %d from active stake queue", entry.stakeID))
+			store.Delete(entry.key)
+			continue
+		}
 		logger.Info(fmt.Sprintf("Processing expired stakeID %d argumentID %d", stake.ID, stake.ArgumentID))
-		result, err := k.distributeReward(ctx, stake)
+		// distribute in a cached context so a failing stake leaves no partial payouts
+		cacheCtx, write := ctx.CacheContext()
+		result, err := k.distributeReward(cacheCtx, stake)
 		if err != nil {
-			panic(err)
+			logger.Error(fmt.Sprintf("Skipping expired stakeID %d: %s", stake.ID, err.Error()))
+			k.setFailedExpiringStake(ctx, stake.ID, err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeStakeExpiryFailed,
+					sdk.NewAttribute(AttributeKeyStakeID, fmt.Sprintf("%d", stake.ID)),
+					sdk.NewAttribute(AttributeKeyError, err.Error()),
+				),
+			)
+			continue
 		}
+		write()
 		stake.Expired = true
 		stake.Result = &result
 		k.setStake(ctx, stake)
 		k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
-		expiredStakes = append(expiredStakes, stake)
-		return false
-	})
+		k.deleteFailedExpiringStake(ctx, stake.ID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeInterestRewardPaid,
+				sdk.NewAttribute(AttributeKeyStakeID, fmt.Sprintf("%d", stake.ID)),
+				sdk.NewAttribute(AttributeKeyArgumentID, fmt.Sprintf("%d", stake.ArgumentID)),
+				sdk.NewAttribute(AttributeKeyCreator, stake.Creator.String()),
+				sdk.NewAttribute(AttributeKeyReward, stakerReward(result).String()),
+			),
+		)
+	}
 
-	if len(expiredStakes) == 0 {
+	if exhausted || len(entries) == 0 {
+		store.Delete(ActiveStakeQueueCursorKey)
 		return
 	}
+	store.Set(ActiveStakeQueueCursorKey, entries[len(entries)-1].key)
+}
 
-	b, err := k.codec.MarshalJSON(expiredStakes)
-	if err != nil {
-		panic(err)
+// stakerReward returns the interest paid to the stake creator
+func stakerReward(result RewardResult) sdk.Coin {
+	if result.Type == RewardResultArgumentCreation {
+		return result.ArgumentCreatorReward
 	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			EventTypeInterestRewardPaid,
-			sdk.NewAttribute(AttributeKeyExpiredStakes, string(b)),
-		),
-	)
+	return result.StakeCreatorReward
 }
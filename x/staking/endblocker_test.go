@@ -46,8 +46,8 @@ func TestKeeper_TestEarnedCoins(t *testing.T) {
 	earnings := make(map[string]UserEarnedCoins)
 	earnings[usersEarnings[0].Address.String()] = usersEarnings[0]
 	earnings[usersEarnings[1].Address.String()] = usersEarnings[1]
-	argumentInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
-	upvoteInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
+	argumentInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
+	upvoteInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
 	upvoteAfterSplitInterest := upvoteInterest.Mul(sdk.NewDecWithPrec(50, 2)).RoundInt()
 
 	assert.Equal(t, argumentInterest.String(), earnings[addr.String()].Coins.AmountOf("crypto").String())
@@ -192,8 +192,8 @@ func TestKeeper_TestStakeRewardResult(t *testing.T) {
 	assert.Equal(t, RewardResultUpvoteSplit, stakes[1].Result.Type)
 	assert.Equal(t, RewardResultArgumentCreation, stakesUser2[0].Result.Type)
 
-	argumentInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
-	upvoteInterest := k.interest(ctx, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
+	argumentInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), time.Hour*24*7).RoundInt()
+	upvoteInterest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10), time.Hour*24*7)
 	upvoteAfterSplitInterest := upvoteInterest.Mul(sdk.NewDecWithPrec(50, 2)).RoundInt()
 
 	assert.Equal(t, argumentInterest.String(), stakes[0].Result.ArgumentCreatorReward.Amount.String())
@@ -211,3 +211,128 @@ func TestKeeper_TestStakeRewardResult(t *testing.T) {
 	assert.Equal(t, c.AmountOf(app.StakeDenom).String(), result.AmountOf(app.StakeDenom).String())
 
 }
+
+func TestKeeper_ProcessExpiringStakesCap(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	p := k.GetParams(ctx)
+	p.MaxExpiringStakesPerBlock = 2
+	k.SetParams(ctx, p)
+
+	for i := 1; i <= 3; i++ {
+		_, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+			"arg1", "summary1", addr, uint64(i), StakeChallenge)
+		assert.NoError(t, err)
+	}
+
+	blockCtx := ctx.WithBlockTime(mustParseTime("2019-01-08")).WithEventManager(sdk.NewEventManager())
+	EndBlocker(blockCtx, k)
+	events := stakingEvents(blockCtx.EventManager().Events())
+	assert.Len(t, events, 2)
+	assert.Equal(t, EventTypeInterestRewardPaid, events[0].Type)
+	assert.Equal(t, AttributeKeyStakeID, string(events[0].Attributes[0].Key))
+	assert.Equal(t, "1", string(events[0].Attributes[0].Value))
+	assert.Equal(t, addr.String(), string(events[0].Attributes[2].Value))
+	assert.NotNil(t, k.store(ctx).Get(ActiveStakeQueueCursorKey))
+	stake, ok := k.Stake(ctx, 3)
+	assert.True(t, ok)
+	assert.False(t, stake.Expired)
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-08")), k)
+	stake, _ = k.Stake(ctx, 3)
+	assert.True(t, stake.Expired)
+	assert.Nil(t, k.store(ctx).Get(ActiveStakeQueueCursorKey))
+}
+
+func TestKeeper_ProcessExpiringStakesSkipsFailures(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+
+	broken, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg1", "summary1", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"arg2", "summary2", addr, 2, StakeChallenge)
+	assert.NoError(t, err)
+	k.store(ctx).Delete(argumentKey(broken.ID))
+
+	blockCtx := ctx.WithBlockTime(mustParseTime("2019-01-08")).WithEventManager(sdk.NewEventManager())
+	assert.NotPanics(t, func() { EndBlocker(blockCtx, k) })
+	events := stakingEvents(blockCtx.EventManager().Events())
+	assert.Len(t, events, 2)
+	assert.Equal(t, EventTypeStakeExpiryFailed, events[0].Type)
+	assert.Equal(t, EventTypeInterestRewardPaid, events[1].Type)
+
+	failed := make([]uint64, 0)
+	k.IterateFailedExpiringStakes(ctx, func(stakeID uint64, reason string) bool {
+		failed = append(failed, stakeID)
+		return false
+	})
+	assert.Equal(t, []uint64{1}, failed)
+	stake, _ := k.Stake(ctx, 1)
+	assert.False(t, stake.Expired)
+	stake, _ = k.Stake(ctx, 2)
+	assert.True(t, stake.Expired)
+	// the failed stake is still held by the pool
+	c := mdb.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins()
+	assert.Equal(t, sdk.Coins{k.GetParams(ctx).ArgumentCreationStake}, c)
+}
+
+// stakingEvents filters out the bank transfer events
+func stakingEvents(events sdk.Events) sdk.Events {
+	filtered := sdk.Events{}
+	for _, e := range events {
+		if e.Type == EventTypeInterestRewardPaid || e.Type == EventTypeStakeExpiryFailed {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+func TestEndBlocker_CloseDeactivatedAccounts(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedAccountKeeper := mdb.accountKeeper.(*mockedAccountKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-01")),
+		"body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	mockedAccountKeeper.deactivate(addr)
+
+	_, err = k.SubmitArgument(ctx.WithBlockTime(mustParseTime("2019-01-02")),
+		"body2", "summary2", addr, 1, StakeChallenge)
+	assert.Equal(t, ErrorCodeAccountDeactivated, err.Code())
+	_, err = k.SubmitUpvote(ctx.WithBlockTime(mustParseTime("2019-01-02")), argument.ID, addr)
+	assert.Equal(t, ErrorCodeAccountDeactivated, err.Code())
+
+	// the account stays open while its stake is active
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-02")), k)
+	assert.False(t, mockedAccountKeeper.closed[addr.String()])
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-13")), k)
+	assert.True(t, mockedAccountKeeper.closed[addr.String()])
+}
+
+func TestEndBlocker_CloseDeactivatedAccountsCapped(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	mockedAccountKeeper := mdb.accountKeeper.(*mockedAccountKeeper)
+	p := k.GetParams(ctx)
+	p.MaxAccountClosuresPerBlock = 1
+	k.SetParams(ctx, p)
+	_, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+	mockedAccountKeeper.deactivate(addr1)
+	mockedAccountKeeper.deactivate(addr2)
+
+	// a failing account is skipped without halting the chain
+	mockedAccountKeeper.fail()
+	k.closeDeactivatedAccounts(ctx)
+	assert.Len(t, mockedAccountKeeper.closed, 0)
+
+	k.closeDeactivatedAccounts(ctx)
+	assert.Len(t, mockedAccountKeeper.closed, 1)
+	k.closeDeactivatedAccounts(ctx)
+	assert.Len(t, mockedAccountKeeper.closed, 1)
+	k.closeDeactivatedAccounts(ctx)
+	assert.Len(t, mockedAccountKeeper.closed, 2)
+}
@@ -14,29 +14,44 @@ func (e Error) Error() string { return string(e) }
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	ErrorCodeInvalidStakeType                sdk.CodeType = 501
-	ErrorCodeAccountJailed                   sdk.CodeType = 502
-	ErrorCodeInvalidBodyLength               sdk.CodeType = 503
-	ErrorCodeInvalidSummaryLength            sdk.CodeType = 504
-	ErrorCodeUnknownArgument                 sdk.CodeType = 505
-	ErrorCodeUnknownStake                    sdk.CodeType = 506
-	ErrorCodeDuplicateStake                  sdk.CodeType = 507
-	ErrorCodeMaxNumOfArgumentsReached        sdk.CodeType = 508
-	ErrorCodeMaxAmountStakingReached         sdk.CodeType = 509
-	ErrorCodeInvalidQueryParams              sdk.CodeType = 510
-	ErrorCodeJSONParsing                     sdk.CodeType = 511
-	ErrorCodeUnknownClaim                    sdk.CodeType = 512
-	ErrorCodeUnknownStakeType                sdk.CodeType = 513
-	ErrorCodeCannotEditArgumentAlreadyStaked sdk.CodeType = 514
-	ErrorCodeCannotEditArgumentWrongCreator  sdk.CodeType = 515
-	ErrorCodeMinBalance                      sdk.CodeType = 516
-	ErrorCodeAddressNotAuthorised            sdk.CodeType = 517
+	ErrorCodeInvalidStakeType                 sdk.CodeType = 501
+	ErrorCodeAccountJailed                    sdk.CodeType = 502
+	ErrorCodeInvalidBodyLength                sdk.CodeType = 503
+	ErrorCodeInvalidSummaryLength             sdk.CodeType = 504
+	ErrorCodeUnknownArgument                  sdk.CodeType = 505
+	ErrorCodeUnknownStake                     sdk.CodeType = 506
+	ErrorCodeDuplicateStake                   sdk.CodeType = 507
+	ErrorCodeMaxNumOfArgumentsReached         sdk.CodeType = 508
+	ErrorCodeMaxAmountStakingReached          sdk.CodeType = 509
+	ErrorCodeInvalidQueryParams               sdk.CodeType = 510
+	ErrorCodeJSONParsing                      sdk.CodeType = 511
+	ErrorCodeUnknownClaim                     sdk.CodeType = 512
+	ErrorCodeUnknownStakeType                 sdk.CodeType = 513
+	ErrorCodeCannotEditArgumentAlreadyStaked  sdk.CodeType = 514
+	ErrorCodeCannotEditArgumentWrongCreator   sdk.CodeType = 515
+	ErrorCodeMinBalance                       sdk.CodeType = 516
+	ErrorCodeAddressNotAuthorised             sdk.CodeType = 517
+	ErrorCodeCannotDeleteArgumentWrongCreator sdk.CodeType = 518
+	ErrorCodeClaimNotOpen                     sdk.CodeType = 519
+	ErrorCodeInvalidParams                    sdk.CodeType = 520
+	ErrorCodeCannotWithdrawStakeWrongCreator  sdk.CodeType = 521
+	ErrorCodeStakeExpired                     sdk.CodeType = 522
+	ErrorCodeUnknownArgumentRevision          sdk.CodeType = 523
+	ErrorCodeAccountDeactivated               sdk.CodeType = 524
+	ErrorCodeCannotDeleteSlashedArgument      sdk.CodeType = 525
 )
 
 // GenesisErrors
 const (
-	ErrInvalidArgumentStakeDenom = Error("invalid denomination for argument stake")
-	ErrInvalidUpvoteStakeDenom   = Error("invalid denomination for upvote stake")
+	ErrInvalidArgumentStakeDenom         = Error("invalid denomination for argument stake")
+	ErrInvalidUpvoteStakeDenom           = Error("invalid denomination for upvote stake")
+	ErrInvalidStakeLimitTiers            = Error("stake limit tiers must be ascending by earned coins with non-decreasing limits above the default")
+	ErrInvalidDefaultStakeLimit          = Error("default stake limit must be positive")
+	ErrInvalidMinimumBalance             = Error("minimum balance must not be negative")
+	ErrInvalidEarlyWithdrawalPenalty     = Error("early withdrawal penalty must be between 0 and 1")
+	ErrInvalidMaxExpiringStakesPerBlock  = Error("max expiring stakes per block must be positive")
+	ErrInvalidMaxResolvedClaimsPerBlock  = Error("max resolved claims per block must be positive")
+	ErrInvalidMaxAccountClosuresPerBlock = Error("max account closures per block must be positive")
 )
 
 // ErrCodeAccountJailed throws an error is in jailed status when performing actions.
@@ -47,6 +62,22 @@ func ErrCodeAccountJailed(acc sdk.AccAddress) sdk.Error {
 	)
 }
 
+// ErrCodeAccountDeactivated throws an error when a deactivated account performs actions.
+func ErrCodeAccountDeactivated(acc sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeAccountDeactivated,
+		fmt.Sprintf("Account is deactivated %s", acc.String()),
+	)
+}
+
+// ErrCodeCannotDeleteSlashedArgument throws an error when deleting an argument that was slashed
+func ErrCodeCannotDeleteSlashedArgument(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotDeleteSlashedArgument,
+		fmt.Sprintf("Argument %d has slashes and cannot be deleted", argumentID),
+	)
+}
+
 // ErrCodeInvalidStakeType throws an error when an invalid stake type is
 func ErrCodeInvalidStakeType(stakeType StakeType) sdk.Error {
 	return sdk.NewError(DefaultCodespace,
@@ -87,6 +118,14 @@ func ErrCodeUnknownClaim(claimID uint64) sdk.Error {
 	)
 }
 
+// ErrCodeClaimNotOpen throws an error when a claim is no longer open for staking
+func ErrCodeClaimNotOpen(claimID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeClaimNotOpen,
+		fmt.Sprintf("Voting has closed on claim id %d", claimID),
+	)
+}
+
 // ErrCodeUnknownStake throws an error when an invalid stake id
 func ErrCodeUnknownStake(stakeID uint64) sdk.Error {
 	return sdk.NewError(DefaultCodespace,
@@ -135,6 +174,14 @@ func ErrCodeCannotEditArgumentWrongCreator(argumentID uint64) sdk.Error {
 	)
 }
 
+// ErrCodeCannotDeleteArgumentWrongCreator throws an error when an argument cannot be deleted because the request is not coming from the creator
+func ErrCodeCannotDeleteArgumentWrongCreator(argumentID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace,
+		ErrorCodeCannotDeleteArgumentWrongCreator,
+		fmt.Sprintf("Argument %d cannot be deleted because you are not the writer of the Argument", argumentID),
+	)
+}
+
 // ErrCodeMaxAmountStakingReached throws an error when you already staked.
 func ErrCodeMaxAmountStakingReached() sdk.Error {
 	return sdk.NewError(DefaultCodespace,
@@ -174,3 +221,35 @@ func ErrAddressNotAuthorised() sdk.Error {
 		ErrorCodeAddressNotAuthorised,
 		"This creator is not authorised to perform this action.")
 }
+
+// ErrCodeInvalidParams throws an error when updated params are inconsistent
+func ErrCodeInvalidParams(err error) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeInvalidParams,
+		fmt.Sprintf("Invalid params: %s", err.Error()))
+}
+
+// ErrCodeCannotWithdrawStakeWrongCreator throws an error when someone other than the staker withdraws a stake
+func ErrCodeCannotWithdrawStakeWrongCreator(stakeID uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeCannotWithdrawStakeWrongCreator,
+		fmt.Sprintf("Only the creator can withdraw stake %d", stakeID))
+}
+
+// ErrCodeStakeExpired throws an error when a stake is no longer active
+func ErrCodeStakeExpired(stakeID uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeStakeExpired,
+		fmt.Sprintf("Stake %d already expired", stakeID))
+}
+
+// ErrCodeUnknownArgumentRevision throws an error when an argument version doesn't exist
+func ErrCodeUnknownArgumentRevision(argumentID, version uint64) sdk.Error {
+	return sdk.NewError(
+		DefaultCodespace,
+		ErrorCodeUnknownArgumentRevision,
+		fmt.Sprintf("Unknown version %d of argument %d", version, argumentID))
+}
@@ -6,22 +6,39 @@ import (
 	"github.com/ahmedaly113/ahchain/x/account"
 	bankexported "github.com/ahmedaly113/ahchain/x/bank/exported"
 	"github.com/ahmedaly113/ahchain/x/claim"
+	"github.com/ahmedaly113/ahchain/x/community"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type AccountKeeper interface {
+	Identity(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddress, sdk.Error)
 	IsJailed(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error)
+	CurrentJailEpisode(ctx sdk.Context, address sdk.AccAddress) (int, sdk.Error)
 	UnJail(ctx sdk.Context, address sdk.AccAddress) sdk.Error
 	IterateAppAccounts(ctx sdk.Context, cb func(acc account.AppAccount) (stop bool))
+	IsDeactivated(ctx sdk.Context, address sdk.AccAddress) (bool, sdk.Error)
+	DeactivatedAccountsAfter(ctx sdk.Context, after sdk.AccAddress, limit int) []account.AppAccount
+	CloseAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Error
 }
 
 type ClaimKeeper interface {
 	Claim(ctx sdk.Context, id uint64) (claim claim.Claim, ok bool)
+	Claims(ctx sdk.Context) (claims claim.Claims)
 	AddBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error
 	AddChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error
 	SubtractBackingStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error
 	SubtractChallengeStake(ctx sdk.Context, id uint64, stake sdk.Coin) sdk.Error
 	SetFirstArgumentTime(ctx sdk.Context, id uint64, firstArgumentTime time.Time) sdk.Error
+	VotingClosedClaims(ctx sdk.Context) claim.Claims
+	VotingClosedClaimsFrom(ctx sdk.Context, startID uint64, limit int) claim.Claims
+	ResolveClaim(ctx sdk.Context, id uint64) sdk.Error
+	GetParams(ctx sdk.Context) claim.Params
+}
+
+// CommunityKeeper is the expected community keeper interface for this module
+type CommunityKeeper interface {
+	Community(ctx sdk.Context, id string) (community community.Community, err sdk.Error)
+	Communities(ctx sdk.Context) (communities []community.Community)
 }
 
 // BankKeeper is the expected bank keeper interface for this module
@@ -32,5 +49,5 @@ type BankKeeper interface {
 	SubtractCoin(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin,
 		referenceID uint64, txType TransactionType, setters ...bankexported.TransactionSetter) (sdk.Coins, sdk.Error)
 	TransactionsByAddress(ctx sdk.Context, address sdk.AccAddress, filterSetters ...bankexported.Filter) []bankexported.Transaction
-	IterateUserTransactions(sdk.Context, sdk.AccAddress, bool, func(tx bankexported.Transaction) bool)
+	TransactionSummary(ctx sdk.Context, address sdk.AccAddress, filterSetters ...bankexported.Filter) []bankexported.TransactionSummary
 }
@@ -14,10 +14,16 @@ type UserEarnedCoins struct {
 
 // GenesisState defines genesis data for the module
 type GenesisState struct {
-	Arguments     []Argument        `json:"arguments"`
-	Params        Params            `json:"params"`
-	Stakes        []Stake           `json:"stakes"`
-	UsersEarnings []UserEarnedCoins `json:"users_earnings"`
+	Arguments         []Argument         `json:"arguments"`
+	ArgumentRevisions []ArgumentRevision `json:"argument_revisions"`
+	Params            Params             `json:"params"`
+	Stakes            []Stake            `json:"stakes"`
+	UsersEarnings     []UserEarnedCoins  `json:"users_earnings"`
+	ArgumentID        uint64             `json:"argument_id"`
+	StakeID           uint64             `json:"stake_id"`
+
+	FailedExpiringStakes   []ProcessingFailure `json:"failed_expiring_stakes"`
+	FailedClaimResolutions []ProcessingFailure `json:"failed_claim_resolutions"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -33,20 +39,36 @@ func NewGenesisState(arguments []Argument, stakes []Stake, userEarnings []UserEa
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:        DefaultParams(),
-		Stakes:        make([]Stake, 0),
-		Arguments:     make([]Argument, 0),
-		UsersEarnings: make([]UserEarnedCoins, 0),
+		Params:            DefaultParams(),
+		Stakes:            make([]Stake, 0),
+		Arguments:         make([]Argument, 0),
+		ArgumentRevisions: make([]ArgumentRevision, 0),
+		UsersEarnings:     make([]UserEarnedCoins, 0),
+
+		FailedExpiringStakes:   make([]ProcessingFailure, 0),
+		FailedClaimResolutions: make([]ProcessingFailure, 0),
 	}
 }
 
 // InitGenesis initializes staking state from genesis file
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	for _, a := range data.Arguments {
+		// arguments exported before revisions were tracked start at version 1
+		if a.Version == 0 {
+			a.Version = 1
+			revisionTime := a.CreatedTime
+			if a.Edited {
+				revisionTime = a.EditedTime
+			}
+			k.setArgumentRevision(ctx, newArgumentRevision(a, a.Creator, revisionTime))
+		}
 		k.setArgument(ctx, a)
 		k.setClaimArgument(ctx, a.ClaimID, a.ID)
 		k.setUserArgument(ctx, a.Creator, a.ID)
 	}
+	for _, r := range data.ArgumentRevisions {
+		k.setArgumentRevision(ctx, r)
+	}
 	mintStakesPool := k.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().Empty()
 	for _, s := range data.Stakes {
 		k.setStake(ctx, s)
@@ -75,8 +97,33 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.setUserCommunityStake(ctx, s.Creator, claim.CommunityID, s.ID)
 
 	}
-	k.setArgumentID(ctx, uint64(len(data.Arguments)+1))
-	k.setStakeID(ctx, uint64(len(data.Stakes)+1))
+	// deleted arguments and their stakes leave gaps, so ids continue after the highest one
+	argumentID := uint64(len(data.Arguments) + 1)
+	for _, a := range data.Arguments {
+		if a.ID >= argumentID {
+			argumentID = a.ID + 1
+		}
+	}
+	if data.ArgumentID > argumentID {
+		argumentID = data.ArgumentID
+	}
+	stakeID := uint64(len(data.Stakes) + 1)
+	for _, s := range data.Stakes {
+		if s.ID >= stakeID {
+			stakeID = s.ID + 1
+		}
+	}
+	if data.StakeID > stakeID {
+		stakeID = data.StakeID
+	}
+	k.setArgumentID(ctx, argumentID)
+	k.setStakeID(ctx, stakeID)
+	for _, f := range data.FailedExpiringStakes {
+		k.setFailedExpiringStake(ctx, f.ID, f.Reason)
+	}
+	for _, f := range data.FailedClaimResolutions {
+		k.setFailedClaimResolution(ctx, f.ID, f.Reason)
+	}
 
 	for _, e := range data.UsersEarnings {
 		e.Coins.Sort()
@@ -85,7 +132,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		}
 		k.setEarnedCoins(ctx, e.Address, e.Coins.Sort())
 	}
-	k.SetParams(ctx, data.Params)
+	k.SetParams(ctx, withDefaultParams(data.Params))
 
 	err := initUserRewardsPool(ctx, k)
 	if err != nil {
@@ -93,6 +140,34 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	}
 }
 
+// withDefaultParams fills the params missing from a genesis exported before they existed
+func withDefaultParams(p Params) Params {
+	defaults := DefaultParams()
+	// tiers arrived together with the default stake limit, an explicitly emptied list is kept
+	if p.DefaultStakeLimit == (sdk.Int{}) {
+		p.DefaultStakeLimit = defaults.DefaultStakeLimit
+		if p.StakeLimitTiers == nil {
+			p.StakeLimitTiers = defaults.StakeLimitTiers
+		}
+	}
+	if p.MinimumBalance == (sdk.Int{}) {
+		p.MinimumBalance = defaults.MinimumBalance
+	}
+	if p.EarlyWithdrawalPenalty.IsNil() {
+		p.EarlyWithdrawalPenalty = defaults.EarlyWithdrawalPenalty
+	}
+	if p.MaxExpiringStakesPerBlock == 0 {
+		p.MaxExpiringStakesPerBlock = defaults.MaxExpiringStakesPerBlock
+	}
+	if p.MaxResolvedClaimsPerBlock == 0 {
+		p.MaxResolvedClaimsPerBlock = defaults.MaxResolvedClaimsPerBlock
+	}
+	if p.MaxAccountClosuresPerBlock == 0 {
+		p.MaxAccountClosuresPerBlock = defaults.MaxAccountClosuresPerBlock
+	}
+	return p
+}
+
 func initUserRewardsPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 	userGrowthAcc := keeper.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName)
 	if userGrowthAcc.GetCoins().Empty() {
@@ -107,21 +182,33 @@ func initUserRewardsPool(ctx sdk.Context, keeper Keeper) sdk.Error {
 
 // ExportGenesis exports the genesis state
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	argumentID, _ := keeper.argumentID(ctx)
+	stakeID, _ := keeper.stakeID(ctx)
 	return GenesisState{
-		Params:        keeper.GetParams(ctx),
-		Arguments:     keeper.Arguments(ctx),
-		Stakes:        keeper.Stakes(ctx),
-		UsersEarnings: keeper.UsersEarnings(ctx),
+		Params:            keeper.GetParams(ctx),
+		Arguments:         keeper.Arguments(ctx),
+		ArgumentRevisions: keeper.allArgumentRevisions(ctx),
+		Stakes:            keeper.Stakes(ctx),
+		UsersEarnings:     keeper.UsersEarnings(ctx),
+		ArgumentID:        argumentID,
+		StakeID:           stakeID,
+
+		FailedExpiringStakes:   keeper.FailedExpiringStakes(ctx),
+		FailedClaimResolutions: keeper.FailedClaimResolutions(ctx),
 	}
 }
 
 // ValidateGenesis validates the genesis state data
 func ValidateGenesis(data GenesisState) error {
+	data.Params = withDefaultParams(data.Params)
 	if data.Params.ArgumentCreationStake.Denom != app.StakeDenom {
 		return ErrInvalidArgumentStakeDenom
 	}
 	if data.Params.UpvoteStake.Denom != app.StakeDenom {
 		return ErrInvalidUpvoteStakeDenom
 	}
+	if err := validateParams(data.Params); err != nil {
+		return err
+	}
 	return nil
 }
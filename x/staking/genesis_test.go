@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/claim"
 )
 
 func TestDefaultGenesisState(t *testing.T) {
@@ -57,6 +58,7 @@ func TestInitGenesis(t *testing.T) {
 		UpvotedCount: 1,
 		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, app.Shanev*10),
 		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*60),
+		Version:      1,
 	}
 
 	expectedSummary := "summary with markdown trustory. and body, testing cuttoff on a URL"
@@ -68,7 +70,13 @@ func TestInitGenesis(t *testing.T) {
 	_, _, admin := keyPubAddr()
 	params.StakingAdmins = append(params.StakingAdmins, admin)
 	genesisState := NewGenesisState(arguments, stakes, usersEarnings, params)
+	genesisState.ArgumentRevisions = []ArgumentRevision{
+		newArgumentRevision(arguments[0], addr1, ctx.BlockHeader().Time),
+	}
+	genesisState.FailedExpiringStakes = []ProcessingFailure{{ID: 2, Reason: "unknown argument"}}
+	genesisState.FailedClaimResolutions = []ProcessingFailure{{ID: 1, Reason: "insufficient funds"}}
 	InitGenesis(ctx, k, genesisState)
+	genesisState.ArgumentID, genesisState.StakeID = 2, 3
 	actualGenesis := ExportGenesis(ctx, k)
 	assert.Equal(t, genesisState, actualGenesis)
 
@@ -99,6 +107,72 @@ func TestInitGenesis(t *testing.T) {
 
 }
 
+func TestInitGenesis_ArgumentsWithoutRevisions(t *testing.T) {
+	ctx, k, _ := mockDB()
+	_, _, addr := keyPubAddr()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-06-01"))
+	argument := Argument{
+		ID:           1,
+		Creator:      addr,
+		ClaimID:      1,
+		Summary:      "edited summary",
+		Body:         "edited body",
+		StakeType:    StakeBacking,
+		CreatedTime:  ctx.BlockHeader().Time,
+		UpdatedTime:  ctx.BlockHeader().Time,
+		EditedTime:   mustParseTime("2019-06-02"),
+		Edited:       true,
+		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+	}
+	InitGenesis(ctx, k, NewGenesisState([]Argument{argument}, nil, nil, DefaultParams()))
+
+	imported, ok := k.Argument(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), imported.Version)
+	revisions := k.ArgumentRevisions(ctx, 1)
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, "edited body", revisions[0].Body)
+	assert.Equal(t, mustParseTime("2019-06-02"), revisions[0].CreatedTime)
+}
+
+func TestGenesis_IDsAfterDeletedArgument(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-06-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := map[uint64]claim.Claim{1: {
+		ID:              1,
+		CommunityID:     "crypto",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}}
+	mdb.claimKeeper.(*mockClaimKeeper).SetClaims(claims)
+
+	deleted, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	kept, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.DeleteArgument(ctx, deleted.ID, addr)
+	assert.NoError(t, err)
+	exported := ExportGenesis(ctx, k)
+
+	ctx2, k2, mdb2 := mockDB()
+	ctx2 = ctx2.WithBlockTime(ctx.BlockHeader().Time)
+	mdb2.claimKeeper.(*mockClaimKeeper).SetClaims(claims)
+	InitGenesis(ctx2, k2, exported)
+	addr2 := createFakeFundedAccount(ctx2, mdb2.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k2.SubmitArgument(ctx2, "body", "summary", addr2, 1, StakeBacking)
+	assert.NoError(t, err)
+	assert.Equal(t, kept.ID+1, argument.ID)
+	// the imported argument and its stake are left untouched
+	imported, ok := k2.Argument(ctx2, kept.ID)
+	assert.True(t, ok)
+	assert.Equal(t, addr, imported.Creator)
+	assert.Len(t, k2.UserStakes(ctx2, addr), 1)
+	assert.Len(t, k2.UserStakes(ctx2, addr2), 1)
+}
+
 func TestValidateGenesis(t *testing.T) {
 	genesisState := NewGenesisState(nil, nil, nil, DefaultParams())
 	genesisState.Params.ArgumentCreationStake.Denom = "my-denom"
@@ -110,4 +184,58 @@ func TestValidateGenesis(t *testing.T) {
 	err = ValidateGenesis(genesisState)
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidUpvoteStakeDenom, err)
+	genesisState.Params.UpvoteStake.Denom = app.StakeDenom
+	genesisState.Params.StakeLimitTiers[0], genesisState.Params.StakeLimitTiers[1] =
+		genesisState.Params.StakeLimitTiers[1], genesisState.Params.StakeLimitTiers[0]
+	err = ValidateGenesis(genesisState)
+	assert.Error(t, err)
+	assert.Equal(t, ErrInvalidStakeLimitTiers, err)
+	genesisState.Params = DefaultParams()
+	genesisState.Params.DefaultStakeLimit = sdk.ZeroInt()
+	err = ValidateGenesis(genesisState)
+	assert.Error(t, err)
+	assert.Equal(t, ErrInvalidDefaultStakeLimit, err)
+}
+
+// genesis in the format exported before the stake limit, withdrawal and expiry params
+const legacyGenesis = `{
+	"arguments": [],
+	"params": {
+		"period": "604800000000000",
+		"argument_creation_stake": {"denom": "utru", "amount": "50000000"},
+		"argument_body_max_length": 1250,
+		"argument_body_min_length": 25,
+		"argument_summary_max_length": 140,
+		"argument_summary_min_length": 25,
+		"upvote_stake": {"denom": "utru", "amount": "10000000"},
+		"creator_share": "0.500000000000000000",
+		"interest_rate": "1.050000000000000000",
+		"staking_admins": [],
+		"stake_limit_percent": "0.667000000000000000",
+		"stake_limit_days": "604800000000000",
+		"unjail_upvotes": 1,
+		"max_arguments_per_claim": 5
+	},
+	"stakes": [],
+	"users_earnings": []
+}`
+
+func TestGenesis_LegacyParams(t *testing.T) {
+	ctx, k, _ := mockDB()
+
+	var state GenesisState
+	ModuleCodec.MustUnmarshalJSON([]byte(legacyGenesis), &state)
+	assert.NoError(t, ValidateGenesis(state))
+
+	InitGenesis(ctx, k, state)
+	params := k.GetParams(ctx)
+	defaults := DefaultParams()
+	assert.Equal(t, defaults.MaxResolvedClaimsPerBlock, params.MaxResolvedClaimsPerBlock)
+	assert.Equal(t, defaults.MaxExpiringStakesPerBlock, params.MaxExpiringStakesPerBlock)
+	assert.Equal(t, defaults.MaxAccountClosuresPerBlock, params.MaxAccountClosuresPerBlock)
+	assert.Equal(t, defaults.ArgumentCreationStake, params.ArgumentCreationStake)
+	assert.Equal(t, defaults.StakeLimitTiers, params.StakeLimitTiers)
+	assert.True(t, defaults.DefaultStakeLimit.Equal(params.DefaultStakeLimit))
+	assert.True(t, defaults.MinimumBalance.Equal(params.MinimumBalance))
+	assert.True(t, defaults.EarlyWithdrawalPenalty.Equal(params.EarlyWithdrawalPenalty))
 }
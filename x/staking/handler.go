@@ -16,6 +16,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitUpvote(ctx, keeper, msg)
 		case MsgEditArgument:
 			return handleMsgEditArgument(ctx, keeper, msg)
+		case MsgDeleteArgument:
+			return handleMsgDeleteArgument(ctx, keeper, msg)
+		case MsgWithdrawStake:
+			return handleMsgWithdrawStake(ctx, keeper, msg)
 		case MsgAddAdmin:
 			return handleMsgAddAdmin(ctx, keeper, msg)
 		case MsgRemoveAdmin:
@@ -82,6 +86,42 @@ func handleMsgEditArgument(ctx sdk.Context, keeper Keeper, msg MsgEditArgument)
 	}
 }
 
+func handleMsgDeleteArgument(ctx sdk.Context, keeper Keeper, msg MsgDeleteArgument) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	argument, err := keeper.DeleteArgument(ctx, msg.ArgumentID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(argument)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgWithdrawStake(ctx sdk.Context, keeper Keeper, msg MsgWithdrawStake) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+	stake, err := keeper.WithdrawStake(ctx, msg.StakeID, msg.Creator)
+	if err != nil {
+		return err.Result()
+	}
+	res, codecErr := ModuleCodec.MarshalJSON(stake)
+	if codecErr != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Marshal result error: %s", codecErr)).Result()
+	}
+	return sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func handleMsgAddAdmin(ctx sdk.Context, k Keeper, msg MsgAddAdmin) sdk.Result {
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
@@ -49,6 +49,46 @@ func TestHandle_SubmitUpvote(t *testing.T) {
 
 }
 
+func TestHandle_DeleteArgument(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	handler := NewHandler(k)
+	addr1 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	res := handler(ctx, NewMsgSubmitArgument(addr1, 1, "summary 1", "body 1", StakeBacking))
+	assert.True(t, res.IsOK())
+
+	msg := NewMsgDeleteArgument(addr1, 1)
+	assert.Equal(t, msg.Route(), RouterKey)
+	assert.Equal(t, msg.Type(), TypeMsgDeleteArgument)
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	_, ok := k.Argument(ctx, 1)
+	assert.False(t, ok)
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr1).AmountOf(app.StakeDenom))
+}
+
+func TestHandle_WithdrawStake(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	handler := NewHandler(k)
+	addr1 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	res := handler(ctx, NewMsgSubmitArgument(addr1, 1, "summary 1", "body 1", StakeBacking))
+	assert.True(t, res.IsOK())
+
+	msg := NewMsgWithdrawStake(addr1, 1)
+	assert.Equal(t, msg.Route(), RouterKey)
+	assert.Equal(t, msg.Type(), TypeMsgWithdrawStake)
+	res = handler(ctx, msg)
+	assert.True(t, res.IsOK())
+
+	stake := Stake{}
+	k.codec.MustUnmarshalJSON(res.Data, &stake)
+	assert.True(t, stake.Withdrawn)
+	assert.Equal(t, sdk.NewInt(app.Shanev*295), k.bankKeeper.GetCoins(ctx, addr1).AmountOf(app.StakeDenom))
+	assert.Equal(t, EventTypeStakeWithdrawn, res.Events[len(res.Events)-1].Type)
+}
+
 func TestHandleMsgAddAdmin(t *testing.T) {
 	ctx, keeper, _ := mockDB()
 	handler := NewHandler(keeper)
@@ -0,0 +1,137 @@
+package staking
+
+import (
+	"fmt"
+	"sort"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	bankexported "github.com/ahmedaly113/ahchain/x/bank/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "stakes-pool", StakesPoolInvariant(k))
+	ir.RegisterRoute(ModuleName, "claim-totals", ClaimTotalsInvariant(k))
+	ir.RegisterRoute(ModuleName, "earned-coins", EarnedCoinsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := StakesPoolInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ClaimTotalsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return EarnedCoinsInvariant(k)(ctx)
+	}
+}
+
+// StakesPoolInvariant checks that the stakes pool holds exactly the coins of all non-expired stakes
+func StakesPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.ZeroInt()
+		for _, stake := range k.Stakes(ctx) {
+			if !stake.Expired {
+				expected = expected.Add(stake.Amount.Amount)
+			}
+		}
+		pool := k.supplyKeeper.GetModuleAccount(ctx, UserStakesPoolName).GetCoins().AmountOf(app.StakeDenom)
+		broken := !pool.Equal(expected)
+		return sdk.FormatInvariant(ModuleName, "stakes-pool",
+			fmt.Sprintf("\tstakes pool balance: %s\n\tsum of non-expired stakes: %s\n", pool, expected)), broken
+	}
+}
+
+// ClaimTotalsInvariant checks that the backing and challenge totals of every claim
+// equal the stakes on its arguments that were neither withdrawn nor slashed.
+// Upvotes on an unhelpful argument stay counted since punishing it leaves them in the totals.
+// Expired stakes are counted since expiry never reduces the claim totals.
+func ClaimTotalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		for _, c := range k.claimKeeper.Claims(ctx) {
+			backed, challenged := sdk.ZeroInt(), sdk.ZeroInt()
+			k.IterateClaimArguments(ctx, c.ID, func(argument Argument) bool {
+				k.IterateArgumentStakes(ctx, argument.ID, func(stake Stake) bool {
+					if stake.Withdrawn {
+						return false
+					}
+					// punishing an argument only takes its own stake out of the claim totals, upvotes stay
+					if argument.IsUnhelpful && stake.Type != StakeUpvote {
+						return false
+					}
+					switch argument.StakeType {
+					case StakeBacking:
+						backed = backed.Add(stake.Amount.Amount)
+					case StakeChallenge:
+						challenged = challenged.Add(stake.Amount.Amount)
+					}
+					return false
+				})
+				return false
+			})
+			if !c.TotalBacked.Amount.Equal(backed) || !c.TotalChallenged.Amount.Equal(challenged) {
+				count++
+				msg += fmt.Sprintf("\tclaim %d totals backed %s challenged %s, stakes backed %s challenged %s\n",
+					c.ID, c.TotalBacked.Amount, c.TotalChallenged.Amount, backed, challenged)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "claim-totals",
+			fmt.Sprintf("%d claims with totals not matching their stakes\n%s", count, msg)), broken
+	}
+}
+
+// EarnedCoinsInvariant checks that the earned coins of every user equal the
+// earnings minus the earning deductions recorded in their bank transactions and rollups
+func EarnedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		k.IterateUserEarnedCoins(ctx, func(address sdk.AccAddress, coins sdk.Coins) bool {
+			expected := make(map[string]sdk.Int)
+			// summaries include the rollups of pruned transactions
+			for _, summary := range k.bankKeeper.TransactionSummary(ctx, address) {
+				amount, ok := expected[summary.CommunityID]
+				if !ok {
+					amount = sdk.ZeroInt()
+				}
+				switch {
+				case summary.Type.OneOf(bankexported.AllowedTransactionsForEarning):
+					expected[summary.CommunityID] = amount.Add(summary.Amount.Amount)
+				case summary.Type.OneOf(bankexported.AllowedTransactionsForEarningDeduction):
+					expected[summary.CommunityID] = amount.Sub(summary.Amount.Amount)
+				}
+			}
+			for _, coin := range coins {
+				if _, ok := expected[coin.Denom]; !ok {
+					expected[coin.Denom] = sdk.ZeroInt()
+				}
+			}
+			communityIDs := make([]string, 0, len(expected))
+			for communityID := range expected {
+				communityIDs = append(communityIDs, communityID)
+			}
+			sort.Strings(communityIDs)
+			for _, communityID := range communityIDs {
+				amount := expected[communityID]
+				earned := coins.AmountOf(communityID)
+				if !earned.Equal(amount) {
+					count++
+					msg += fmt.Sprintf("\t%s earned %s%s, transactions add up to %s%s\n",
+						address, earned, communityID, amount, communityID)
+				}
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "earned-coins",
+			fmt.Sprintf("%d earned coin balances not matching transactions\n%s", count, msg)), broken
+	}
+}
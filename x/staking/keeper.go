@@ -1,9 +1,11 @@
 package staking
 
 import (
+	"fmt"
 	"time"
 
 	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/account"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,30 +16,33 @@ import (
 
 // Keeper is the model object for the package staking module
 type Keeper struct {
-	storeKey      sdk.StoreKey
-	codec         *codec.Codec
-	paramStore    params.Subspace
-	codespace     sdk.CodespaceType
-	bankKeeper    BankKeeper
-	accountKeeper AccountKeeper
-	claimKeeper   ClaimKeeper
-	supplyKeeper  supply.Keeper
+	storeKey        sdk.StoreKey
+	codec           *codec.Codec
+	paramStore      params.Subspace
+	codespace       sdk.CodespaceType
+	bankKeeper      BankKeeper
+	accountKeeper   AccountKeeper
+	claimKeeper     ClaimKeeper
+	communityKeeper CommunityKeeper
+	supplyKeeper    supply.Keeper
 }
 
 // NewKeeper creates a staking keeper.
 func NewKeeper(codec *codec.Codec, storeKey sdk.StoreKey,
-	accountKeeper AccountKeeper, bankKeeper BankKeeper, claimKeeper ClaimKeeper, supplyKeeper supply.Keeper,
+	accountKeeper AccountKeeper, bankKeeper BankKeeper, claimKeeper ClaimKeeper,
+	communityKeeper CommunityKeeper, supplyKeeper supply.Keeper,
 	paramStore params.Subspace,
 	codespace sdk.CodespaceType) Keeper {
 	return Keeper{
-		storeKey:      storeKey,
-		codec:         codec,
-		paramStore:    paramStore.WithKeyTable(ParamKeyTable()),
-		codespace:     codespace,
-		bankKeeper:    bankKeeper,
-		accountKeeper: accountKeeper,
-		claimKeeper:   claimKeeper,
-		supplyKeeper:  supplyKeeper,
+		storeKey:        storeKey,
+		codec:           codec,
+		paramStore:      paramStore.WithKeyTable(ParamKeyTable()),
+		codespace:       codespace,
+		bankKeeper:      bankKeeper,
+		accountKeeper:   accountKeeper,
+		claimKeeper:     claimKeeper,
+		communityKeeper: communityKeeper,
+		supplyKeeper:    supplyKeeper,
 	}
 }
 
@@ -120,7 +125,15 @@ func (k Keeper) UserArguments(ctx sdk.Context, address sdk.AccAddress) []Argumen
 }
 
 func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.AccAddress) (Stake, sdk.Error) {
-	err := k.checkJailed(ctx, creator)
+	creator, err := k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	err = k.checkJailed(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	err = k.checkDeactivated(ctx, creator)
 	if err != nil {
 		return Stake{}, err
 	}
@@ -138,8 +151,11 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 	if !ok {
 		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
 	}
+	if claim.Status != ClaimOpen {
+		return Stake{}, ErrCodeClaimNotOpen(argument.ClaimID)
+	}
 
-	upvoteStake := k.GetParams(ctx).UpvoteStake
+	upvoteStake := k.CommunityParams(ctx, argument.CommunityID).UpvoteStake
 	stake, err := k.newStake(ctx, upvoteStake, creator, StakeUpvote, argumentID, claim.CommunityID)
 	if err != nil {
 		return stake, err
@@ -163,9 +179,65 @@ func (k Keeper) SubmitUpvote(ctx sdk.Context, argumentID uint64, creator sdk.Acc
 		}
 	}
 
+	err = k.rehabilitate(ctx, argument)
+	if err != nil {
+		return Stake{}, err
+	}
+
 	return stake, nil
 }
 
+// rehabilitate unjails the creator of a restricted argument early, once the arguments
+// they posted during their current jail episode collected UnjailUpvotes upvotes.
+// Upvoters are never jailed since jailed users cannot upvote.
+func (k Keeper) rehabilitate(ctx sdk.Context, argument Argument) sdk.Error {
+	unjailUpvotes := k.GetParams(ctx).UnjailUpvotes
+	if argument.JailEpisode == 0 || unjailUpvotes < 1 {
+		return nil
+	}
+	jailEpisode, err := k.accountKeeper.CurrentJailEpisode(ctx, argument.Creator)
+	if err != nil {
+		return err
+	}
+	if jailEpisode != argument.JailEpisode {
+		return nil
+	}
+	upvotes := 0
+	k.IterateUserArguments(ctx, argument.Creator, func(a Argument) bool {
+		if a.JailEpisode == jailEpisode {
+			upvotes += a.UpvotedCount
+		}
+		return false
+	})
+	if upvotes < unjailUpvotes {
+		return nil
+	}
+	err = k.accountKeeper.UnJail(ctx, argument.Creator)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			account.EventTypeUnjailedAccount,
+			sdk.NewAttribute(account.AttributeKeyUser, argument.Creator.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Unjailed %s after %d upvotes", argument.Creator, upvotes))
+
+	return nil
+}
+
+func (k Keeper) checkDeactivated(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
+	deactivated, err := k.accountKeeper.IsDeactivated(ctx, address)
+	if err != nil {
+		return err
+	}
+	if deactivated {
+		return ErrCodeAccountDeactivated(address)
+	}
+	return nil
+}
+
 func (k Keeper) checkJailed(ctx sdk.Context, address sdk.AccAddress) sdk.Error {
 	jailed, err := k.accountKeeper.IsJailed(ctx, address)
 	if err != nil {
@@ -183,7 +255,16 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	if !stakeType.ValidForArgument() {
 		return Argument{}, ErrCodeInvalidStakeType(stakeType)
 	}
-	err := k.checkJailed(ctx, creator)
+	creator, err := k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return Argument{}, err
+	}
+	err = k.checkDeactivated(ctx, creator)
+	if err != nil {
+		return Argument{}, err
+	}
+	// jailed users can still post, in restricted mode
+	jailEpisode, err := k.accountKeeper.CurrentJailEpisode(ctx, creator)
 	if err != nil {
 		return Argument{}, err
 	}
@@ -191,6 +272,9 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	if !ok {
 		return Argument{}, ErrCodeUnknownClaim(claimID)
 	}
+	if claim.Status != ClaimOpen {
+		return Argument{}, ErrCodeClaimNotOpen(claimID)
+	}
 
 	arguments := k.ClaimArguments(ctx, claimID)
 	count := 0
@@ -199,9 +283,13 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 			count++
 		}
 	}
-	p := k.GetParams(ctx)
-	if count >= p.MaxArgumentsPerClaim {
-		return Argument{}, ErrCodeMaxNumOfArgumentsReached(p.MaxArgumentsPerClaim)
+	p := k.CommunityParams(ctx, claim.CommunityID)
+	maxArguments := p.MaxArgumentsPerClaim
+	if jailEpisode > 0 {
+		maxArguments = restrictedArgumentsPerClaim
+	}
+	if count >= maxArguments {
+		return Argument{}, ErrCodeMaxNumOfArgumentsReached(maxArguments)
 	}
 
 	creationAmount := p.ArgumentCreationStake
@@ -223,6 +311,8 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 		TotalStake:   creationAmount,
 		EditedTime:   ctx.BlockHeader().Time,
 		Edited:       false,
+		Version:      1,
+		JailEpisode:  jailEpisode,
 	}
 	_, err = k.newStake(ctx, creationAmount, creator, stakeType, argument.ID, claim.CommunityID)
 	if err != nil {
@@ -230,6 +320,7 @@ func (k Keeper) SubmitArgument(ctx sdk.Context, body, summary string,
 	}
 
 	k.setArgument(ctx, argument)
+	k.setArgumentRevision(ctx, newArgumentRevision(argument, creator, argument.CreatedTime))
 	k.setArgumentID(ctx, argumentID+1)
 	k.setClaimArgument(ctx, claimID, argument.ID)
 	k.setUserArgument(ctx, creator, argument.ID)
@@ -267,6 +358,15 @@ func (k Keeper) Argument(ctx sdk.Context, argumentID uint64) (Argument, bool) {
 	return argument, true
 }
 
+// ArgumentCreator returns the creator of an argument and the community it was made in
+func (k Keeper) ArgumentCreator(ctx sdk.Context, argumentID uint64) (creator sdk.AccAddress, communityID string, ok bool) {
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return nil, "", false
+	}
+	return argument.Creator, argument.CommunityID, true
+}
+
 func (k Keeper) MarkUnhelpfulArgument(ctx sdk.Context, argumentID uint64) sdk.Error {
 	arg, ok := k.Argument(ctx, argumentID)
 	if !ok {
@@ -278,6 +378,18 @@ func (k Keeper) MarkUnhelpfulArgument(ctx sdk.Context, argumentID uint64) sdk.Er
 	return nil
 }
 
+// UnmarkUnhelpfulArgument clears the unhelpful mark of an argument whose punishment was reverted
+func (k Keeper) UnmarkUnhelpfulArgument(ctx sdk.Context, argumentID uint64) sdk.Error {
+	arg, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return ErrCodeUnknownArgument(argumentID)
+	}
+	arg.IsUnhelpful = false
+	k.setArgument(ctx, arg)
+
+	return nil
+}
+
 func (k Keeper) DownvoteArgument(ctx sdk.Context, argumentID uint64) sdk.Error {
 	arg, ok := k.Argument(ctx, argumentID)
 	if !ok {
@@ -348,29 +460,188 @@ func (k Keeper) isAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	return false
 }
 
-func (k Keeper) setArgument(ctx sdk.Context, argument Argument) {
-	bz := k.codec.MustMarshalBinaryLengthPrefixed(argument)
-	k.store(ctx).Set(argumentKey(argument.ID), bz)
+// DeleteArgument lets the creator or a staking admin remove an argument while its claim is open.
+// Active stakes are refunded and rolled back from the claim totals. Slashed arguments
+// can't be deleted, their slashes, punishment and appeal keep pointing at them.
+// Jailed users can post restricted arguments, so they can delete them as well.
+func (k Keeper) DeleteArgument(ctx sdk.Context, argumentID uint64, creator sdk.AccAddress) (Argument, sdk.Error) {
+	creator, err := k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return Argument{}, err
+	}
+	err = k.checkDeactivated(ctx, creator)
+	if err != nil {
+		return Argument{}, err
+	}
+	argument, ok := k.Argument(ctx, argumentID)
+	if !ok {
+		return Argument{}, ErrCodeUnknownArgument(argumentID)
+	}
+	if !argument.Creator.Equals(creator) && !k.isAdmin(ctx, creator) {
+		return Argument{}, ErrCodeCannotDeleteArgumentWrongCreator(argumentID)
+	}
+	claim, ok := k.claimKeeper.Claim(ctx, argument.ClaimID)
+	if !ok {
+		return Argument{}, ErrCodeUnknownClaim(argument.ClaimID)
+	}
+	// a closed claim is settled by its stakes
+	if claim.Status != ClaimOpen {
+		return Argument{}, ErrCodeClaimNotOpen(argument.ClaimID)
+	}
+	// every slash downvotes the argument
+	if argument.DownvotedCount > 0 || argument.IsUnhelpful {
+		return Argument{}, ErrCodeCannotDeleteSlashedArgument(argumentID)
+	}
+
+	stakes := k.ArgumentStakes(ctx, argumentID)
+	for _, stake := range stakes {
+		// expired stakes have already been refunded (or slashed)
+		if !stake.Expired {
+			err := k.refundStake(ctx, argument, stake)
+			if err != nil {
+				return Argument{}, err
+			}
+		}
+		k.deleteStake(ctx, stake)
+	}
+
+	k.deleteClaimArgument(ctx, argument.ClaimID, argument.ID)
+	k.deleteUserArgument(ctx, argument.Creator, argument.ID)
+	for _, revision := range k.ArgumentRevisions(ctx, argument.ID) {
+		k.store(ctx).Delete(argumentRevisionKey(argument.ID, revision.Version))
+	}
+	k.store(ctx).Delete(argumentKey(argument.ID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeArgumentDeleted,
+			sdk.NewAttribute(AttributeKeyArgumentID, fmt.Sprintf("%d", argument.ID)),
+			sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", argument.ClaimID)),
+			sdk.NewAttribute(AttributeKeyCreator, argument.Creator.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Deleted argument %d by %s", argument.ID, creator))
+
+	return argument, nil
 }
 
-var tierLimitsEarnedCoins = []sdk.Int{
-	sdk.NewInt(app.Shanev * 10),
-	sdk.NewInt(app.Shanev * 20),
-	sdk.NewInt(app.Shanev * 30),
-	sdk.NewInt(app.Shanev * 40),
-	sdk.NewInt(app.Shanev * 50),
+// WithdrawStake lets a staker exit an active stake before its end time.
+// A share of the principal defined by EarlyWithdrawalPenalty goes to the user
+// reward pool, no interest is paid and the stake no longer counts towards the
+// argument and claim totals or the staker's stake limit.
+func (k Keeper) WithdrawStake(ctx sdk.Context, stakeID uint64, creator sdk.AccAddress) (Stake, sdk.Error) {
+	creator, err := k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	err = k.checkJailed(ctx, creator)
+	if err != nil {
+		return Stake{}, err
+	}
+	stake, ok := k.Stake(ctx, stakeID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownStake(stakeID)
+	}
+	if !stake.Creator.Equals(creator) {
+		return Stake{}, ErrCodeCannotWithdrawStakeWrongCreator(stakeID)
+	}
+	if stake.Expired {
+		return Stake{}, ErrCodeStakeExpired(stakeID)
+	}
+	argument, ok := k.Argument(ctx, stake.ArgumentID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownArgument(stake.ArgumentID)
+	}
+	claim, ok := k.claimKeeper.Claim(ctx, argument.ClaimID)
+	if !ok {
+		return Stake{}, ErrCodeUnknownClaim(argument.ClaimID)
+	}
+	// once voting closed the stake belongs to the claim resolution
+	if claim.Status != ClaimOpen {
+		return Stake{}, ErrCodeClaimNotOpen(argument.ClaimID)
+	}
+
+	penaltyRate := k.GetParams(ctx).EarlyWithdrawalPenalty
+	penalty := sdk.NewCoin(stake.Amount.Denom, penaltyRate.MulInt(stake.Amount.Amount).TruncateInt())
+	refund := stake.Amount.Sub(penalty)
+	if penalty.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, UserStakesPoolName, UserRewardPoolName, sdk.NewCoins(penalty))
+		if err != nil {
+			return Stake{}, err
+		}
+	}
+	if refund.IsPositive() {
+		_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, refund, stake.ArgumentID,
+			TransactionStakeWithdrawn, WithCommunityID(stake.CommunityID),
+			FromModuleAccount(UserStakesPoolName),
+		)
+		if err != nil {
+			return Stake{}, err
+		}
+	}
+
+	argument.TotalStake = argument.TotalStake.Sub(stake.Amount)
+	if stake.Type == StakeUpvote {
+		argument.UpvotedCount = argument.UpvotedCount - 1
+		argument.UpvotedStake = argument.UpvotedStake.Sub(stake.Amount)
+	}
+	argument.UpdatedTime = ctx.BlockHeader().Time
+	k.setArgument(ctx, argument)
+
+	switch {
+	case argument.StakeType == StakeBacking:
+		err = k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
+	case argument.StakeType == StakeChallenge:
+		err = k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
+	}
+	if err != nil {
+		return Stake{}, err
+	}
+
+	// expired stakes are skipped by checkStakeThreshold, freeing the staker's window
+	stake.Expired = true
+	stake.Withdrawn = true
+	k.setStake(ctx, stake)
+	k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeStakeWithdrawn,
+			sdk.NewAttribute(AttributeKeyStakeID, fmt.Sprintf("%d", stake.ID)),
+			sdk.NewAttribute(AttributeKeyArgumentID, fmt.Sprintf("%d", stake.ArgumentID)),
+			sdk.NewAttribute(AttributeKeyCreator, stake.Creator.String()),
+			sdk.NewAttribute(AttributeKeyPenalty, penalty.String()),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("Withdrew stake %d by %s", stake.ID, creator))
+
+	return stake, nil
 }
 
-var tierLimitsStakeAmounts = []sdk.Int{
-	sdk.NewInt(app.Shanev * 1000),
-	sdk.NewInt(app.Shanev * 1500),
-	sdk.NewInt(app.Shanev * 2000),
-	sdk.NewInt(app.Shanev * 2500),
-	sdk.NewInt(app.Shanev * 3000),
+// refundStake returns an active stake to its creator and rolls it back from the claim totals
+func (k Keeper) refundStake(ctx sdk.Context, argument Argument, stake Stake) sdk.Error {
+	_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, stake.Amount, stake.ArgumentID,
+		stake.Type.RefundTransactionType(), WithCommunityID(argument.CommunityID),
+		FromModuleAccount(UserStakesPoolName),
+	)
+	if err != nil {
+		return err
+	}
+	k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+
+	switch {
+	case argument.StakeType == StakeBacking:
+		return k.claimKeeper.SubtractBackingStake(ctx, argument.ClaimID, stake.Amount)
+	case argument.StakeType == StakeChallenge:
+		return k.claimKeeper.SubtractChallengeStake(ctx, argument.ClaimID, stake.Amount)
+	}
+	return nil
 }
 
-var defaultStakeLimit = sdk.NewInt(app.Shanev * 500)
-var defaultMinimumBalance = sdk.NewInt(app.Shanev * 50)
+func (k Keeper) setArgument(ctx sdk.Context, argument Argument) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(argument)
+	k.store(ctx).Set(argumentKey(argument.ID), bz)
+}
 
 func (k Keeper) checkStakeThreshold(ctx sdk.Context, address sdk.AccAddress, amount sdk.Int) sdk.Error {
 	balance := k.bankKeeper.GetCoins(ctx, address).AmountOf(app.StakeDenom)
@@ -378,61 +649,84 @@ func (k Keeper) checkStakeThreshold(ctx sdk.Context, address sdk.AccAddress, amo
 		return sdk.ErrInsufficientFunds("Insufficient coins")
 	}
 	p := k.GetParams(ctx)
-	period := p.Period
+	if balance.Sub(amount).LT(p.MinimumBalance) {
+		return ErrCodeMinBalance()
+	}
+	limit := k.StakeLimit(ctx, address)
+	if limit.Staked.Add(amount).GT(limit.StakeLimit) {
+		return ErrCodeMaxAmountStakingReached()
+	}
+	return nil
+}
 
+// longestPeriod returns the longest staking period across the global params and community overrides
+func (k Keeper) longestPeriod(ctx sdk.Context) time.Duration {
+	period := k.GetParams(ctx).Period
+	for _, c := range k.communityKeeper.Communities(ctx) {
+		if c.StakingOverrides.Period != nil && *c.StakingOverrides.Period > period {
+			period = *c.StakingOverrides.Period
+		}
+	}
+	return period
+}
+
+// StakeLimit returns the stake limit tier of a user along with the amount
+// staked and still available within the current staking period.
+// Each stake counts within the period of its own community.
+func (k Keeper) StakeLimit(ctx sdk.Context, address sdk.AccAddress) StakeLimitStatus {
+	p := k.GetParams(ctx)
 	staked := sdk.NewInt(0)
-	fromDate := ctx.BlockHeader().Time.Add(time.Duration(-1) * period)
+	now := ctx.BlockHeader().Time
+	periods := make(map[string]time.Duration)
+	fromDate := now.Add(time.Duration(-1) * k.longestPeriod(ctx))
 	k.IterateAfterCreatedTimeUserStakes(ctx, address,
 		fromDate, func(stake Stake) bool {
 			// only account for non expired since expired would already have refunded the stake
 			if stake.Expired {
 				return false
 			}
+			period, ok := periods[stake.CommunityID]
+			if !ok {
+				period = k.CommunityParams(ctx, stake.CommunityID).Period
+				periods[stake.CommunityID] = period
+			}
+			if stake.CreatedTime.Before(now.Add(time.Duration(-1) * period)) {
+				return false
+			}
 			staked = staked.Add(stake.Amount.Amount)
 			return false
 		},
 	)
-	if balance.Sub(amount).LT(defaultMinimumBalance) {
-		return ErrCodeMinBalance()
-	}
 
-	switch totalEarned := k.TotalEarnedCoins(ctx, address); {
-	// if total earned >= 50
-	case totalEarned.GTE(tierLimitsEarnedCoins[4]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[4]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 40
-	case totalEarned.GTE(tierLimitsEarnedCoins[3]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[3]) {
-			return ErrCodeMaxAmountStakingReached()
+	totalEarned := k.TotalEarnedCoins(ctx, address)
+	status := StakeLimitStatus{
+		Address:               address,
+		EarnedCoins:           totalEarned,
+		StakeLimit:            p.DefaultStakeLimit,
+		Staked:                staked,
+		EarnedCoinsToNextTier: sdk.ZeroInt(),
+	}
+	// tiers are sorted ascending by earned coins so the last one reached wins
+	for i, tier := range p.StakeLimitTiers {
+		if totalEarned.LT(tier.EarnedCoins) {
+			next := tier
+			status.NextTier = &next
+			status.EarnedCoinsToNextTier = tier.EarnedCoins.Sub(totalEarned)
+			break
 		}
-		return nil
-	// if total earned >= 30
-	case totalEarned.GTE(tierLimitsEarnedCoins[2]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[2]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 20
-	case totalEarned.GTE(tierLimitsEarnedCoins[1]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[1]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	// if total earned >= 10
-	case totalEarned.GTE(tierLimitsEarnedCoins[0]):
-		if staked.Add(amount).GT(tierLimitsStakeAmounts[0]) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
-	default:
-		if staked.Add(amount).GT(defaultStakeLimit) {
-			return ErrCodeMaxAmountStakingReached()
-		}
-		return nil
+		status.Tier = i + 1
+		status.StakeLimit = tier.StakeLimit
 	}
+	status.Remaining = sdk.ZeroInt()
+	if status.StakeLimit.GT(staked) {
+		status.Remaining = status.StakeLimit.Sub(staked)
+	}
+	return status
+}
+
+// CommunityEarnedCoins returns the coins a user earned in a single community
+func (k Keeper) CommunityEarnedCoins(ctx sdk.Context, address sdk.AccAddress, communityID string) sdk.Int {
+	return k.getEarnedCoins(ctx, address).AmountOf(communityID)
 }
 
 func (k Keeper) TotalEarnedCoins(ctx sdk.Context, creator sdk.AccAddress) sdk.Int {
@@ -453,7 +747,7 @@ func (k Keeper) newStake(ctx sdk.Context, amount sdk.Coin, creator sdk.AccAddres
 	if err != nil {
 		return Stake{}, err
 	}
-	period := k.GetParams(ctx).Period
+	period := k.CommunityParams(ctx, communityID).Period
 	stakeID, err := k.stakeID(ctx)
 	if err != nil {
 		return Stake{}, err
@@ -502,6 +796,15 @@ func (k Keeper) setStake(ctx sdk.Context, stake Stake) {
 	k.store(ctx).Set(stakeKey(stake.ID), bz)
 }
 
+// deleteStake removes a stake and all of its associations from the store
+func (k Keeper) deleteStake(ctx sdk.Context, stake Stake) {
+	k.deleteArgumentStake(ctx, stake.ArgumentID, stake.ID)
+	k.deleteUserStake(ctx, stake.Creator, stake.CreatedTime, stake.ID)
+	k.deleteCommunityStake(ctx, stake.CommunityID, stake.ID)
+	k.deleteUserCommunityStake(ctx, stake.Creator, stake.CommunityID, stake.ID)
+	k.store(ctx).Delete(stakeKey(stake.ID))
+}
+
 func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
 	return gaskv.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), ctx.GasMeter(), app.KVGasConfig())
 }
@@ -534,7 +837,8 @@ func (k Keeper) setEarnedCoins(ctx sdk.Context, user sdk.AccAddress, earnedCoins
 	k.store(ctx).Set(userEarnedCoinsKey(user), b)
 }
 
-func (k Keeper) addEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
+// AddEarnedCoin adds to the coins a user earned in a community
+func (k Keeper) AddEarnedCoin(ctx sdk.Context, user sdk.AccAddress, communityID string, amount sdk.Int) {
 	earnedCoins := k.getEarnedCoins(ctx, user)
 	earnedCoins = earnedCoins.Add(sdk.NewCoins(sdk.NewCoin(communityID, amount)))
 	k.setEarnedCoins(ctx, user, earnedCoins)
@@ -604,7 +908,11 @@ func (k Keeper) UsersEarnings(ctx sdk.Context) []UserEarnedCoins {
 func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 	creator sdk.AccAddress, argumentID uint64) (Argument, sdk.Error) {
 
-	err := k.checkJailed(ctx, creator)
+	creator, err := k.accountKeeper.Identity(ctx, creator)
+	if err != nil {
+		return Argument{}, err
+	}
+	err = k.checkJailed(ctx, creator)
 	if err != nil {
 		return Argument{}, err
 	}
@@ -625,23 +933,66 @@ func (k Keeper) EditArgument(ctx sdk.Context, body, summary string,
 		return Argument{}, ErrCodeCannotEditArgumentAlreadyStaked(argumentID)
 	}
 
-	editedArgument := Argument{
-		ID:           argumentID,
-		Creator:      argument.Creator,
-		ClaimID:      argument.ClaimID,
-		CommunityID:  argument.CommunityID,
-		Summary:      summary,
-		Body:         body,
-		StakeType:    argument.StakeType,
-		CreatedTime:  argument.CreatedTime,
-		UpdatedTime:  argument.UpdatedTime,
-		UpvotedStake: argument.UpvotedStake,
-		TotalStake:   argument.TotalStake,
-		UpvotedCount: argument.UpvotedCount,
-		EditedTime:   ctx.BlockHeader().Time,
-		Edited:       true,
-	}
+	editedArgument := argument
+	editedArgument.Summary = summary
+	editedArgument.Body = body
+	editedArgument.EditedTime = ctx.BlockHeader().Time
+	editedArgument.Edited = true
+	editedArgument.Version = argument.Version + 1
 
 	k.setArgument(ctx, editedArgument)
-	return argument, nil
+	k.setArgumentRevision(ctx, newArgumentRevision(editedArgument, creator, editedArgument.EditedTime))
+	return editedArgument, nil
+}
+
+// ArgumentRevisions returns every stored version of an argument, oldest first
+func (k Keeper) ArgumentRevisions(ctx sdk.Context, argumentID uint64) []ArgumentRevision {
+	revisions := make([]ArgumentRevision, 0)
+	k.IterateArgumentRevisions(ctx, argumentID, func(revision ArgumentRevision) bool {
+		revisions = append(revisions, revision)
+		return false
+	})
+	return revisions
+}
+
+// ArgumentRevision returns the content of an argument at the given version
+func (k Keeper) ArgumentRevision(ctx sdk.Context, argumentID, version uint64) (ArgumentRevision, bool) {
+	revision := ArgumentRevision{}
+	bz := k.store(ctx).Get(argumentRevisionKey(argumentID, version))
+	if bz == nil {
+		return revision, false
+	}
+	k.codec.MustUnmarshalBinaryLengthPrefixed(bz, &revision)
+	return revision, true
+}
+
+func (k Keeper) allArgumentRevisions(ctx sdk.Context) []ArgumentRevision {
+	revisions := make([]ArgumentRevision, 0)
+	k.iterateRevisions(ctx, ArgumentRevisionsKeyPrefix, func(revision ArgumentRevision) bool {
+		revisions = append(revisions, revision)
+		return false
+	})
+	return revisions
+}
+
+// IterateArgumentRevisions iterates over the versions of an argument, oldest first
+func (k Keeper) IterateArgumentRevisions(ctx sdk.Context, argumentID uint64, cb func(revision ArgumentRevision) (stop bool)) {
+	k.iterateRevisions(ctx, argumentRevisionsPrefix(argumentID), cb)
+}
+
+func (k Keeper) iterateRevisions(ctx sdk.Context, prefix []byte, cb func(revision ArgumentRevision) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(k.store(ctx), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revision ArgumentRevision
+		k.codec.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revision)
+		if cb(revision) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setArgumentRevision(ctx sdk.Context, revision ArgumentRevision) {
+	bz := k.codec.MustMarshalBinaryLengthPrefixed(revision)
+	k.store(ctx).Set(argumentRevisionKey(revision.ArgumentID, revision.Version), bz)
 }
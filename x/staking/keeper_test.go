@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/account"
 	"github.com/ahmedaly113/ahchain/x/bank"
 	"github.com/ahmedaly113/ahchain/x/claim"
+	"github.com/ahmedaly113/ahchain/x/community"
 )
 
 func TestKeeper_SubmitArgumentMaxLimit(t *testing.T) {
@@ -37,10 +39,8 @@ func TestKeeper_SubmitArgumentMaxLimit(t *testing.T) {
 func TestKeeper_SubmitArgument(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	ctx = ctx.WithBlockTime(time.Now())
-	mockedAccountKeeper := mdb.accountKeeper.(*mockedAccountKeeper)
 	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
 	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
-	mockedAccountKeeper.jail(addr)
 
 	_, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeUpvote)
 	assert.Error(t, err)
@@ -50,11 +50,6 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, ErrorCodeInvalidStakeType, err.Code())
 
-	_, err = k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
-	assert.Error(t, err)
-	assert.Equal(t, ErrorCodeAccountJailed, err.Code())
-	_ = mdb.accountKeeper.UnJail(ctx, addr)
-
 	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
 	assert.NoError(t, err)
 	expectedArgument := Argument{
@@ -71,6 +66,7 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 		UpvotedCount: 0,
 		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
 		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Version:      1,
 	}
 	assert.Equal(t, expectedArgument, argument)
 	argument, ok := k.Argument(ctx, expectedArgument.ID)
@@ -103,6 +99,7 @@ func TestKeeper_SubmitArgument(t *testing.T) {
 		UpdatedTime:  ctx.BlockHeader().Time,
 		UpvotedStake: sdk.NewInt64Coin(app.StakeDenom, 0),
 		TotalStake:   sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50),
+		Version:      1,
 	}
 	expectedStake2 := Stake{
 		ID:          2,
@@ -244,6 +241,71 @@ func TestKeeper_AfterTimeStakesIterator(t *testing.T) {
 	assert.Len(t, stakes, 2)
 }
 
+func TestKeeper_CommunityParamsOverrides(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	period := time.Hour * 24 * 14
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*20)
+	maxArguments := 1
+	interestRate := sdk.NewDecWithPrec(50, 2)
+	mdb.communityKeeper.communities["testunit"] = community.Community{
+		ID: "testunit",
+		StakingOverrides: community.StakingOverrides{
+			Period:               &period,
+			UpvoteStake:          &upvoteStake,
+			MaxArgumentsPerClaim: &maxArguments,
+			InterestRate:         &interestRate,
+		},
+	}
+
+	p := k.CommunityParams(ctx, "testunit")
+	assert.Equal(t, period, p.Period)
+	assert.Equal(t, upvoteStake, p.UpvoteStake)
+	assert.Equal(t, maxArguments, p.MaxArgumentsPerClaim)
+	assert.Equal(t, interestRate, p.InterestRate)
+	// fields that are not overridden keep the global value
+	assert.Equal(t, k.GetParams(ctx).ArgumentCreationStake, p.ArgumentCreationStake)
+	// unknown communities use the global params
+	assert.Equal(t, k.GetParams(ctx), k.CommunityParams(ctx, "unknown"))
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	s, ok := k.Stake(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(period), s.EndTime)
+
+	_, err = k.SubmitArgument(ctx, "body2", "summary2", addr, 1, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeMaxNumOfArgumentsReached, err.Code())
+
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	assert.Equal(t, upvoteStake, upvote.Amount)
+	assert.Equal(t, ctx.BlockHeader().Time.Add(period), upvote.EndTime)
+
+	// the stake limit window follows the community period, not the global one
+	later := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period + time.Hour))
+	assert.Equal(t, s.Amount.Amount, k.StakeLimit(later, addr).Staked)
+	later = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(period + time.Hour))
+	assert.True(t, k.StakeLimit(later, addr).Staked.IsZero())
+}
+
+func TestKeeper_CommunityParamsShortPeriod(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	period := time.Hour
+	mdb.communityKeeper.communities["testunit"] = community.Community{
+		ID:               "testunit",
+		StakingOverrides: community.StakingOverrides{Period: &period},
+	}
+
+	// stakes can't expire before voting on their claim closes
+	p := k.CommunityParams(ctx, "testunit")
+	assert.Equal(t, claim.DefaultParams().VotingPeriod, p.Period)
+}
+
 func TestKeeper_SubmitUpvote(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	ctx = ctx.WithBlockTime(time.Now())
@@ -336,13 +398,57 @@ func TestKeeper_SubmitUpvote(t *testing.T) {
 	assert.Equal(t, sdk.NewInt64Coin(app.StakeDenom, app.Shanev*70), argument.TotalStake)
 }
 
+func TestKeeper_RestrictedArgumentUnjail(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedAccountKeeper := mdb.accountKeeper.(*mockedAccountKeeper)
+	p := k.GetParams(ctx)
+	p.UnjailUpvotes = 2
+	k.SetParams(ctx, p)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr4 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	mockedAccountKeeper.jail(addr)
+	mockedAccountKeeper.jail(addr4)
+
+	// jailed users post one restricted argument per claim
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, argument.JailEpisode)
+	_, err = k.SubmitArgument(ctx, "body2", "summary2", addr, 1, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeMaxNumOfArgumentsReached, err.Code())
+
+	// jailed users can't upvote
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr4)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeAccountJailed, err.Code())
+
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	jailed, _ := mockedAccountKeeper.IsJailed(ctx, addr)
+	assert.True(t, jailed)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SubmitUpvote(ctx, argument.ID, addr3)
+	assert.NoError(t, err)
+	jailed, _ = mockedAccountKeeper.IsJailed(ctx, addr)
+	assert.False(t, jailed)
+	unjailed := false
+	for _, event := range ctx.EventManager().Events() {
+		unjailed = unjailed || event.Type == account.EventTypeUnjailedAccount
+	}
+	assert.True(t, unjailed)
+}
+
 func Test_interest(t *testing.T) {
 	ctx, k, _ := mockDB()
 	amount := sdk.NewInt64Coin(app.StakeDenom, 50000000000)
 	now := time.Now()
 	p := k.GetParams(ctx)
 	after7days := now.Add(p.Period)
-	interest := k.interest(ctx, amount, after7days.Sub(now))
+	interest := k.interest(ctx, "crypto", amount, after7days.Sub(now))
 	assert.Equal(t, sdk.NewInt(1006849315), interest.RoundInt())
 }
 
@@ -352,7 +458,7 @@ func Test_splitReward(t *testing.T) {
 	now := time.Now()
 	p := k.GetParams(ctx)
 	after7days := now.Add(p.Period)
-	interest := k.interest(ctx, amount, after7days.Sub(now))
+	interest := k.interest(ctx, "crypto", amount, after7days.Sub(now))
 	t.Log("interest: " + interest.String())
 
 	creatorReward, stakerReward := k.splitReward(ctx, interest)
@@ -393,7 +499,7 @@ func TestKeeper_StakeLimitTiers(t *testing.T) {
 		{"2500 limit", 2700, 51, 49},
 		{"3000 limit", 5000, 61, 51},
 	}
-	assert.Len(t, tierTests, len(tierLimitsEarnedCoins))
+	assert.Len(t, tierTests, len(DefaultParams().StakeLimitTiers))
 	for _, tt := range tierTests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx, k, mdb := mockDB()
@@ -415,6 +521,40 @@ func TestKeeper_StakeLimitTiers(t *testing.T) {
 	}
 
 }
+
+func TestKeeper_StakeLimitUpdatedTiers(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*700)})
+	k.setEarnedCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("crypto", app.Shanev*5)))
+	admin := k.GetParams(ctx).StakingAdmins[0]
+
+	updates := Params{
+		StakeLimitTiers: []StakeLimitTier{
+			{EarnedCoins: sdk.NewInt(app.Shanev * 5), StakeLimit: sdk.NewInt(app.Shanev * 600)},
+		},
+	}
+	err := k.UpdateParams(ctx, admin, updates, []string{"stake_limit_tiers"})
+	assert.NoError(t, err)
+
+	limit := k.StakeLimit(ctx, addr)
+	assert.Equal(t, 1, limit.Tier)
+	assert.Equal(t, sdk.NewInt(app.Shanev*600), limit.StakeLimit)
+	assert.Nil(t, limit.NextTier)
+	for i := 1; i <= 12; i++ {
+		_, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, uint64(i), StakeChallenge)
+		assert.NoError(t, err)
+	}
+	_, err = k.SubmitArgument(ctx, "arg1", "summary1", addr, 13, StakeChallenge)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeMaxAmountStakingReached, err.Code())
+
+	// tiers below the default stake limit are rejected
+	updates.StakeLimitTiers[0].StakeLimit = sdk.NewInt(app.Shanev * 100)
+	err = k.UpdateParams(ctx, admin, updates, []string{"stake_limit_tiers"})
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidParams, err.Code())
+}
+
 func TestKeeper_StakeLimitDefaultTier(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*700)})
@@ -589,3 +729,255 @@ func TestRemoveAdmin_RemoverNotAuthorised(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrAddressNotAuthorised().Code(), err.Code())
 }
+
+func TestKeeper_DeleteArgument(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+
+	_, err = k.DeleteArgument(ctx, 99, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownArgument, err.Code())
+
+	_, err = k.DeleteArgument(ctx, argument.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotDeleteArgumentWrongCreator, err.Code())
+
+	_, err = k.DeleteArgument(ctx, argument.ID, addr)
+	assert.NoError(t, err)
+
+	_, ok := k.Argument(ctx, argument.ID)
+	assert.False(t, ok)
+	_, ok = k.Stake(ctx, upvote.ID)
+	assert.False(t, ok)
+	assert.Len(t, k.ClaimArguments(ctx, 1), 0)
+	assert.Len(t, k.UserArguments(ctx, addr), 0)
+	assert.Len(t, k.UserStakes(ctx, addr2), 0)
+	assert.Len(t, k.CommunityStakes(ctx, "crypto"), 0)
+	assert.Len(t, k.UserCommunityStakes(ctx, addr2, "crypto"), 0)
+
+	expiringStakes := make([]Stake, 0)
+	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period), func(stake Stake) bool {
+		expiringStakes = append(expiringStakes, stake)
+		return false
+	})
+	assert.Len(t, expiringStakes, 0)
+
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+
+	claim1, ok := mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.True(t, claim1.TotalBacked.IsZero())
+}
+
+func TestKeeper_DeleteArgumentWhileJailed(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	mdb.accountKeeper.(*mockedAccountKeeper).jail(addr)
+
+	_, err = k.DeleteArgument(ctx, argument.ID, addr)
+	assert.NoError(t, err)
+	_, ok := k.Argument(ctx, argument.ID)
+	assert.False(t, ok)
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+}
+
+func TestKeeper_DeleteArgumentRestrictions(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	slashed, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	assert.NoError(t, k.DownvoteArgument(ctx, slashed.ID))
+	_, err = k.DeleteArgument(ctx, slashed.ID, addr)
+	assert.Equal(t, ErrorCodeCannotDeleteSlashedArgument, err.Code())
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	c := claims[1]
+	c.Status = claim.ClaimVotingClosed
+	claims[1] = c
+	_, err = k.DeleteArgument(ctx, argument.ID, addr)
+	assert.Equal(t, ErrorCodeClaimNotOpen, err.Code())
+
+	c.Status = claim.ClaimOpen
+	claims[1] = c
+	mdb.accountKeeper.(*mockedAccountKeeper).deactivate(addr)
+	_, err = k.DeleteArgument(ctx, argument.ID, addr)
+	assert.Equal(t, ErrorCodeAccountDeactivated, err.Code())
+}
+
+func TestKeeper_WithdrawStake(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	rewardPool := k.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName).GetCoins().AmountOf(app.StakeDenom)
+
+	_, err = k.WithdrawStake(ctx, 99, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownStake, err.Code())
+
+	_, err = k.WithdrawStake(ctx, upvote.ID, addr)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeCannotWithdrawStakeWrongCreator, err.Code())
+
+	withdrawn, err := k.WithdrawStake(ctx, upvote.ID, addr2)
+	assert.NoError(t, err)
+	assert.True(t, withdrawn.Expired)
+	assert.True(t, withdrawn.Withdrawn)
+
+	_, err = k.WithdrawStake(ctx, upvote.ID, addr2)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeStakeExpired, err.Code())
+
+	// 10% of the 10 upvote stake is forfeited
+	assert.Equal(t, sdk.NewInt(app.Shanev*299), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+	assert.Equal(t, rewardPool.Add(sdk.NewInt(app.Shanev*1)),
+		k.supplyKeeper.GetModuleAccount(ctx, UserRewardPoolName).GetCoins().AmountOf(app.StakeDenom))
+
+	argument, ok := k.Argument(ctx, argument.ID)
+	assert.True(t, ok)
+	assert.Equal(t, 0, argument.UpvotedCount)
+	assert.True(t, argument.UpvotedStake.IsZero())
+	assert.Equal(t, k.GetParams(ctx).ArgumentCreationStake, argument.TotalStake)
+	claim1, ok := mockedClaimKeeper.Claim(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, k.GetParams(ctx).ArgumentCreationStake, claim1.TotalBacked)
+	assert.True(t, k.StakeLimit(ctx, addr2).Staked.IsZero())
+
+	expiringStakes := make([]Stake, 0)
+	k.IterateActiveStakeQueue(ctx, ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period), func(stake Stake) bool {
+		expiringStakes = append(expiringStakes, stake)
+		return false
+	})
+	assert.Len(t, expiringStakes, 1)
+	assert.Equal(t, argument.ID, expiringStakes[0].ArgumentID)
+}
+
+func TestKeeper_Invariants(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	mockedClaimKeeper.enableTrackStake = true
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:              1,
+		CommunityID:     "crypto",
+		Body:            "body",
+		Creator:         addr,
+		TotalBacked:     sdk.NewInt64Coin(app.StakeDenom, 0),
+		TotalChallenged: sdk.NewInt64Coin(app.StakeDenom, 0),
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	upvote, err := k.SubmitUpvote(ctx, argument.ID, addr2)
+	assert.NoError(t, err)
+	_, err = k.WithdrawStake(ctx, upvote.ID, addr2)
+	assert.NoError(t, err)
+
+	// expire the argument stake so interest is paid out
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(k.GetParams(ctx).Period + time.Second))
+	k.processExpiringStakes(ctx)
+	assert.True(t, k.TotalEarnedCoins(ctx, addr).IsPositive())
+
+	msg, broken := AllInvariants(k)(ctx)
+	assert.False(t, broken, msg)
+
+	c, _ := mockedClaimKeeper.Claim(ctx, 1)
+	c.TotalBacked = c.TotalBacked.Add(sdk.NewInt64Coin(app.StakeDenom, app.Shanev))
+	claims[1] = c
+	_, broken = ClaimTotalsInvariant(k)(ctx)
+	assert.True(t, broken)
+
+	k.AddEarnedCoin(ctx, addr2, "crypto", sdk.NewInt(app.Shanev))
+	_, broken = EarnedCoinsInvariant(k)(ctx)
+	assert.True(t, broken)
+
+	err = k.supplyKeeper.MintCoins(ctx, UserStakesPoolName, sdk.NewCoins(sdk.NewInt64Coin(app.StakeDenom, app.Shanev)))
+	assert.NoError(t, err)
+	_, broken = StakesPoolInvariant(k)(ctx)
+	assert.True(t, broken)
+}
+
+func TestKeeper_DeleteArgumentByAdmin(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	admin := k.GetParams(ctx).StakingAdmins[0]
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+
+	_, err = k.DeleteArgument(ctx, argument.ID, admin)
+	assert.NoError(t, err)
+	_, ok := k.Argument(ctx, argument.ID)
+	assert.False(t, ok)
+	assert.Equal(t, sdk.NewInt(app.Shanev*300), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+}
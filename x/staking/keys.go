@@ -12,6 +12,8 @@ var (
 	StakesKeyPrefix      = []byte{0x00}
 	ArgumentsKeyPrefix   = []byte{0x01}
 	EarnedCoinsKeyPrefix = []byte{0x02}
+	// ArgumentRevisionsKeyPrefix stores every version of an argument's content
+	ArgumentRevisionsKeyPrefix = []byte{0x03}
 
 	// ID Keys
 	StakeIDKey    = []byte{0x10}
@@ -27,6 +29,14 @@ var (
 
 	// Queue
 	ActiveStakeQueuePrefix = []byte{0x40}
+	// ActiveStakeQueueCursorKey holds the last queue key visited when a block hit the expiry cap
+	ActiveStakeQueueCursorKey  = []byte{0x41}
+	FailedExpiringStakesPrefix = []byte{0x42}
+	// ClosedClaimsCursorKey holds the next claim ID to resolve when a block hit the resolution cap
+	ClosedClaimsCursorKey        = []byte{0x43}
+	FailedClaimResolutionsPrefix = []byte{0x44}
+	// DeactivatedAccountsCursorKey holds the last deactivated account checked when a block hit the closing cap
+	DeactivatedAccountsCursorKey = []byte{0x45}
 )
 
 // stakeKey gets a key for a stake.
@@ -41,6 +51,19 @@ func argumentKey(id uint64) []byte {
 	return buildKey(ArgumentsKeyPrefix, id)
 }
 
+// argumentRevisionsPrefix
+// 0x03<argument_id>
+func argumentRevisionsPrefix(argumentID uint64) []byte {
+	return buildKey(ArgumentRevisionsKeyPrefix, argumentID)
+}
+
+// argumentRevisionKey gets a key for an argument revision
+// 0x03<argument_id><version>
+func argumentRevisionKey(argumentID, version uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(version)
+	return append(argumentRevisionsPrefix(argumentID), bz...)
+}
+
 // 0x02<user>
 func userEarnedCoinsKey(user sdk.AccAddress) []byte {
 	return append(EarnedCoinsKeyPrefix, user.Bytes()...)
@@ -146,6 +169,18 @@ func activeStakeByTimeKey(endTime time.Time) []byte {
 	return append(ActiveStakeQueuePrefix, sdk.FormatTimeBytes(endTime)...)
 }
 
+// failedExpiringStakeKey
+// 0x42<stake_id>
+func failedExpiringStakeKey(stakeID uint64) []byte {
+	return buildKey(FailedExpiringStakesPrefix, stakeID)
+}
+
+// failedClaimResolutionKey
+// 0x44<claim_id>
+func failedClaimResolutionKey(claimID uint64) []byte {
+	return buildKey(FailedClaimResolutionsPrefix, claimID)
+}
+
 func buildKey(prefix []byte, id uint64) []byte {
 	bz := sdk.Uint64ToBigEndian(id)
 	return append(prefix, bz...)
@@ -10,6 +10,7 @@ import (
 var _ sdk.Msg = &MsgSubmitArgument{}
 var _ sdk.Msg = &MsgSubmitUpvote{}
 var _ sdk.Msg = &MsgDeleteArgument{}
+var _ sdk.Msg = &MsgWithdrawStake{}
 var _ sdk.Msg = &MsgEditArgument{}
 var _ sdk.Msg = &MsgAddAdmin{}
 var _ sdk.Msg = &MsgRemoveAdmin{}
@@ -19,6 +20,7 @@ const (
 	TypeMsgSubmitArgument = "submit_argument"
 	TypeMsgSubmitUpvote   = "submit_upvote"
 	TypeMsgDeleteArgument = "delete_argument"
+	TypeMsgWithdrawStake  = "withdraw_stake"
 	TypeMsgEditArgument   = "edit_argument"
 	TypeMsgAddAdmin       = "add_admin"
 	TypeMsgRemoveAdmin    = "remove_admin"
@@ -127,6 +129,14 @@ type MsgDeleteArgument struct {
 	Creator    sdk.AccAddress `json:"creator"`
 }
 
+// NewMsgDeleteArgument returns a new delete argument message.
+func NewMsgDeleteArgument(creator sdk.AccAddress, argumentID uint64) MsgDeleteArgument {
+	return MsgDeleteArgument{
+		ArgumentID: argumentID,
+		Creator:    creator,
+	}
+}
+
 func (MsgDeleteArgument) Route() string {
 	return RouterKey
 }
@@ -153,6 +163,46 @@ func (msg MsgDeleteArgument) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+// MsgWithdrawStake msg for exiting an active stake before it expires.
+type MsgWithdrawStake struct {
+	StakeID uint64         `json:"stake_id"`
+	Creator sdk.AccAddress `json:"creator"`
+}
+
+// NewMsgWithdrawStake returns a new withdraw stake message.
+func NewMsgWithdrawStake(creator sdk.AccAddress, stakeID uint64) MsgWithdrawStake {
+	return MsgWithdrawStake{
+		StakeID: stakeID,
+		Creator: creator,
+	}
+}
+
+func (MsgWithdrawStake) Route() string {
+	return RouterKey
+}
+
+func (MsgWithdrawStake) Type() string {
+	return TypeMsgWithdrawStake
+}
+
+func (msg MsgWithdrawStake) ValidateBasic() sdk.Error {
+	if len(msg.Creator) == 0 {
+		return sdk.ErrInvalidAddress("Must provide a valid address")
+	}
+	return nil
+}
+
+// GetSignBytes gets the bytes for Msg signer to sign on
+func (msg MsgWithdrawStake) GetSignBytes() []byte {
+	msgBytes := ModuleCodec.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(msgBytes)
+}
+
+// GetSigners gets the signs of the Msg
+func (msg MsgWithdrawStake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 // MsgEditArgument msg for creating an argument.
 type MsgEditArgument struct {
 	Creator    sdk.AccAddress `json:"creator"`
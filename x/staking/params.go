@@ -12,20 +12,27 @@ import (
 )
 
 var (
-	ParamKeyPeriod                   = []byte("period")
-	ParamKeyArgumentCreationStake    = []byte("argumentCreationStake")
-	ParamKeyArgumentBodyMaxLength    = []byte("argumentBodyMaxLength")
-	ParamKeyArgumentBodyMinLength    = []byte("argumentBodyMinLength")
-	ParamKeyArgumentSummaryMaxLength = []byte("argumentSummaryMaxLength")
-	ParamKeyArgumentSummaryMinLength = []byte("argumentSummaryMinLength")
-	ParamKeyUpvoteStake              = []byte("upvoteStake")
-	ParamKeyCreatorShare             = []byte("creatorShare")
-	ParamKeyInterestRate             = []byte("interestRate")
-	ParamKeyStakingAdmins            = []byte("stakingAdmins")
-	ParamKeyStakeLimitPercent        = []byte("stakeLimitPercent")
-	ParamKeyStakeLimitDays           = []byte("stakeLimitDays")
-	ParamKeyUnjailUpvotes            = []byte("unjailUpvotes")
-	ParamKeyMaxArgumentsPerClaim     = []byte("maxArgumentsPerClaim")
+	ParamKeyPeriod                     = []byte("period")
+	ParamKeyArgumentCreationStake      = []byte("argumentCreationStake")
+	ParamKeyArgumentBodyMaxLength      = []byte("argumentBodyMaxLength")
+	ParamKeyArgumentBodyMinLength      = []byte("argumentBodyMinLength")
+	ParamKeyArgumentSummaryMaxLength   = []byte("argumentSummaryMaxLength")
+	ParamKeyArgumentSummaryMinLength   = []byte("argumentSummaryMinLength")
+	ParamKeyUpvoteStake                = []byte("upvoteStake")
+	ParamKeyCreatorShare               = []byte("creatorShare")
+	ParamKeyInterestRate               = []byte("interestRate")
+	ParamKeyStakingAdmins              = []byte("stakingAdmins")
+	ParamKeyStakeLimitPercent          = []byte("stakeLimitPercent")
+	ParamKeyStakeLimitDays             = []byte("stakeLimitDays")
+	ParamKeyUnjailUpvotes              = []byte("unjailUpvotes")
+	ParamKeyMaxArgumentsPerClaim       = []byte("maxArgumentsPerClaim")
+	ParamKeyStakeLimitTiers            = []byte("stakeLimitTiers")
+	ParamKeyDefaultStakeLimit          = []byte("defaultStakeLimit")
+	ParamKeyMinimumBalance             = []byte("minimumBalance")
+	ParamKeyEarlyWithdrawalPenalty     = []byte("earlyWithdrawalPenalty")
+	ParamKeyMaxExpiringStakesPerBlock  = []byte("maxExpiringStakesPerBlock")
+	ParamKeyMaxResolvedClaimsPerBlock  = []byte("maxResolvedClaimsPerBlock")
+	ParamKeyMaxAccountClosuresPerBlock = []byte("maxAccountClosuresPerBlock")
 )
 
 type Params struct {
@@ -42,9 +49,20 @@ type Params struct {
 	// deprecated
 	StakeLimitPercent sdk.Dec `json:"stake_limit_percent"`
 	// deprecated
-	StakeLimitDays       time.Duration `json:"stake_limit_days"`
-	UnjailUpvotes        int           `json:"unjail_upvotes"`
-	MaxArgumentsPerClaim int           `json:"max_arguments_per_claim"`
+	StakeLimitDays       time.Duration    `json:"stake_limit_days"`
+	UnjailUpvotes        int              `json:"unjail_upvotes"`
+	MaxArgumentsPerClaim int              `json:"max_arguments_per_claim"`
+	StakeLimitTiers      []StakeLimitTier `json:"stake_limit_tiers"`
+	DefaultStakeLimit    sdk.Int          `json:"default_stake_limit"`
+	MinimumBalance       sdk.Int          `json:"minimum_balance"`
+	// share of principal forfeited when a stake is withdrawn before its end time
+	EarlyWithdrawalPenalty sdk.Dec `json:"early_withdrawal_penalty"`
+	// caps the stakes expired per block, the rest are picked up in the following blocks
+	MaxExpiringStakesPerBlock int `json:"max_expiring_stakes_per_block"`
+	// caps the closed claims resolved per block, the rest are picked up in the following blocks
+	MaxResolvedClaimsPerBlock int `json:"max_resolved_claims_per_block"`
+	// caps the deactivated accounts checked for closing per block
+	MaxAccountClosuresPerBlock int `json:"max_account_closures_per_block"`
 }
 
 func DefaultParams() Params {
@@ -63,6 +81,19 @@ func DefaultParams() Params {
 		StakeLimitDays:           time.Hour * 24 * 7,
 		UnjailUpvotes:            1,
 		MaxArgumentsPerClaim:     5,
+		StakeLimitTiers: []StakeLimitTier{
+			{EarnedCoins: sdk.NewInt(app.Shanev * 10), StakeLimit: sdk.NewInt(app.Shanev * 1000)},
+			{EarnedCoins: sdk.NewInt(app.Shanev * 20), StakeLimit: sdk.NewInt(app.Shanev * 1500)},
+			{EarnedCoins: sdk.NewInt(app.Shanev * 30), StakeLimit: sdk.NewInt(app.Shanev * 2000)},
+			{EarnedCoins: sdk.NewInt(app.Shanev * 40), StakeLimit: sdk.NewInt(app.Shanev * 2500)},
+			{EarnedCoins: sdk.NewInt(app.Shanev * 50), StakeLimit: sdk.NewInt(app.Shanev * 3000)},
+		},
+		DefaultStakeLimit:          sdk.NewInt(app.Shanev * 500),
+		MinimumBalance:             sdk.NewInt(app.Shanev * 50),
+		EarlyWithdrawalPenalty:     sdk.NewDecWithPrec(10, 2),
+		MaxExpiringStakesPerBlock:  100,
+		MaxResolvedClaimsPerBlock:  20,
+		MaxAccountClosuresPerBlock: 20,
 	}
 }
 
@@ -82,6 +113,13 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: ParamKeyStakeLimitDays, Value: &p.StakeLimitDays},
 		{Key: ParamKeyUnjailUpvotes, Value: &p.UnjailUpvotes},
 		{Key: ParamKeyMaxArgumentsPerClaim, Value: &p.MaxArgumentsPerClaim},
+		{Key: ParamKeyStakeLimitTiers, Value: &p.StakeLimitTiers},
+		{Key: ParamKeyDefaultStakeLimit, Value: &p.DefaultStakeLimit},
+		{Key: ParamKeyMinimumBalance, Value: &p.MinimumBalance},
+		{Key: ParamKeyEarlyWithdrawalPenalty, Value: &p.EarlyWithdrawalPenalty},
+		{Key: ParamKeyMaxExpiringStakesPerBlock, Value: &p.MaxExpiringStakesPerBlock},
+		{Key: ParamKeyMaxResolvedClaimsPerBlock, Value: &p.MaxResolvedClaimsPerBlock},
+		{Key: ParamKeyMaxAccountClosuresPerBlock, Value: &p.MaxAccountClosuresPerBlock},
 	}
 }
 
@@ -97,6 +135,37 @@ func (k Keeper) GetParams(ctx sdk.Context) Params {
 	return paramSet
 }
 
+// CommunityParams returns the staking params with the overrides set by a community applied.
+// A period override shorter than the claim voting period is raised to it,
+// so stakes stay locked until voting on their claim has closed.
+func (k Keeper) CommunityParams(ctx sdk.Context, communityID string) Params {
+	p := k.GetParams(ctx)
+	c, err := k.communityKeeper.Community(ctx, communityID)
+	if err != nil {
+		return p
+	}
+	overrides := c.StakingOverrides
+	if overrides.InterestRate != nil {
+		p.InterestRate = *overrides.InterestRate
+	}
+	if overrides.Period != nil {
+		p.Period = *overrides.Period
+		if votingPeriod := k.claimKeeper.GetParams(ctx).VotingPeriod; p.Period < votingPeriod {
+			p.Period = votingPeriod
+		}
+	}
+	if overrides.ArgumentCreationStake != nil {
+		p.ArgumentCreationStake = *overrides.ArgumentCreationStake
+	}
+	if overrides.UpvoteStake != nil {
+		p.UpvoteStake = *overrides.UpvoteStake
+	}
+	if overrides.MaxArgumentsPerClaim != nil {
+		p.MaxArgumentsPerClaim = *overrides.MaxArgumentsPerClaim
+	}
+	return p
+}
+
 // SetParams sets the params for staking module
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	logger := ctx.Logger().With("module", ModuleName)
@@ -113,6 +182,9 @@ func (k Keeper) UpdateParams(ctx sdk.Context, updater sdk.AccAddress, updates Pa
 
 	current := k.GetParams(ctx)
 	updated := k.getUpdatedParams(current, updates, updatedFields)
+	if err := validateParams(updated); err != nil {
+		return ErrCodeInvalidParams(err)
+	}
 	k.SetParams(ctx, updated)
 
 	return nil
@@ -133,6 +205,39 @@ func (k Keeper) getUpdatedParams(current Params, updates Params, updatedFields [
 	return updated
 }
 
+// validateParams checks that tiers are sorted by earned coins, that no tier
+// grants a lower stake limit than the default or the previous tier and that
+// the early withdrawal penalty is a valid share.
+func validateParams(p Params) error {
+	if p.MaxExpiringStakesPerBlock <= 0 {
+		return ErrInvalidMaxExpiringStakesPerBlock
+	}
+	if p.MaxResolvedClaimsPerBlock <= 0 {
+		return ErrInvalidMaxResolvedClaimsPerBlock
+	}
+	if p.MaxAccountClosuresPerBlock <= 0 {
+		return ErrInvalidMaxAccountClosuresPerBlock
+	}
+	if p.EarlyWithdrawalPenalty.IsNil() || p.EarlyWithdrawalPenalty.IsNegative() ||
+		p.EarlyWithdrawalPenalty.GT(sdk.OneDec()) {
+		return ErrInvalidEarlyWithdrawalPenalty
+	}
+	if !p.DefaultStakeLimit.IsPositive() {
+		return ErrInvalidDefaultStakeLimit
+	}
+	if p.MinimumBalance.IsNegative() {
+		return ErrInvalidMinimumBalance
+	}
+	previous := StakeLimitTier{EarnedCoins: sdk.ZeroInt(), StakeLimit: p.DefaultStakeLimit}
+	for _, tier := range p.StakeLimitTiers {
+		if !tier.EarnedCoins.GT(previous.EarnedCoins) || tier.StakeLimit.LT(previous.StakeLimit) {
+			return ErrInvalidStakeLimitTiers
+		}
+		previous = tier
+	}
+	return nil
+}
+
 func isIn(needle string, haystack []string) bool {
 	for _, value := range haystack {
 		if needle == value {
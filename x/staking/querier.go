@@ -1,25 +1,34 @@
 package staking
 
 import (
+	"fmt"
+
 	app "github.com/ahmedaly113/ahchain/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 const (
-	QueryClaimArgument       = "claim_argument"
-	QueryClaimArguments      = "claim_arguments"
-	QueryUserArguments       = "user_arguments"
-	QueryArgumentStakes      = "argument_stakes"
-	QueryCommunityStakes     = "community_stakes"
-	QueryStake               = "stake"
-	QueryArgumentsByIDs      = "arguments_ids"
-	QueryUserStakes          = "user_stakes"
-	QueryUserCommunityStakes = "user_community_stakes"
-	QueryClaimTopArgument    = "claim_top_argument"
-	QueryEarnedCoins         = "earned_coins"
-	QueryTotalEarnedCoins    = "total_earned_coins"
-	QueryParams              = "params"
+	QueryClaimArgument        = "claim_argument"
+	QueryClaimArguments       = "claim_arguments"
+	QueryUserArguments        = "user_arguments"
+	QueryArgumentStakes       = "argument_stakes"
+	QueryCommunityStakes      = "community_stakes"
+	QueryStake                = "stake"
+	QueryArgumentsByIDs       = "arguments_ids"
+	QueryUserStakes           = "user_stakes"
+	QueryUserCommunityStakes  = "user_community_stakes"
+	QueryClaimTopArgument     = "claim_top_argument"
+	QueryEarnedCoins          = "earned_coins"
+	QueryTotalEarnedCoins     = "total_earned_coins"
+	QueryParams               = "params"
+	QueryStakeLimit           = "stake_limit"
+	QueryArgumentRevisions    = "argument_revisions"
+	QueryClaimRankedArguments = "claim_ranked_arguments"
+	QueryCommunityParams      = "community_params"
+
+	QueryFailedExpiringStakes   = "failed_expiring_stakes"
+	QueryFailedClaimResolutions = "failed_claim_resolutions"
 )
 
 type QueryClaimArgumentParams struct {
@@ -27,19 +36,23 @@ type QueryClaimArgumentParams struct {
 }
 
 type QueryClaimArgumentsParams struct {
-	ClaimID uint64 `json:"claim_id"`
+	ClaimID    uint64         `json:"claim_id"`
+	Pagination app.Pagination `json:"pagination"`
 }
 
 type QueryUserArgumentsParams struct {
-	Address sdk.AccAddress `json:"address"`
+	Address    sdk.AccAddress `json:"address"`
+	Pagination app.Pagination `json:"pagination"`
 }
 
 type QueryArgumentStakesParams struct {
-	ArgumentID uint64 `json:"argument_id"`
+	ArgumentID uint64         `json:"argument_id"`
+	Pagination app.Pagination `json:"pagination"`
 }
 
 type QueryCommunityStakesParams struct {
-	CommunityID string `json:"community_id"`
+	CommunityID string         `json:"community_id"`
+	Pagination  app.Pagination `json:"pagination"`
 }
 
 type QueryStakeParams struct {
@@ -51,18 +64,30 @@ type QueryArgumentsByIDsParams struct {
 }
 
 type QueryUserStakesParams struct {
-	Address sdk.AccAddress `json:"address"`
+	Address    sdk.AccAddress `json:"address"`
+	Pagination app.Pagination `json:"pagination"`
 }
 
 type QueryUserCommunityStakesParams struct {
 	Address     sdk.AccAddress `json:"address"`
 	CommunityID string         `json:"community_id"`
+	Pagination  app.Pagination `json:"pagination"`
 }
 
 type QueryClaimTopArgumentParams struct {
 	ClaimID uint64 `json:"claim_id"`
 }
 
+// QueryClaimRankedArgumentsParams ranks the arguments of a claim with the given strategy.
+// Limit and Offset apply to the backing and challenge lists separately.
+type QueryClaimRankedArgumentsParams struct {
+	ClaimID           uint64          `json:"claim_id"`
+	Strategy          RankingStrategy `json:"strategy"`
+	PenalizeDownvotes bool            `json:"penalize_downvotes"`
+	Limit             int             `json:"limit"`
+	Offset            int             `json:"offset"`
+}
+
 type QueryEarnedCoinsParams struct {
 	Address sdk.AccAddress `json:"address"`
 }
@@ -71,6 +96,40 @@ type QueryTotalEarnedCoinsParams struct {
 	Address sdk.AccAddress `json:"address"`
 }
 
+type QueryStakeLimitParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryCommunityParamsParams returns the staking params with the overrides of a community applied
+type QueryCommunityParamsParams struct {
+	CommunityID string `json:"community_id"`
+}
+
+// QueryArgumentRevisionsParams returns every revision of an argument,
+// or only the given version when Version is set
+type QueryArgumentRevisionsParams struct {
+	ArgumentID uint64 `json:"argument_id"`
+	Version    uint64 `json:"version,omitempty"`
+}
+
+// StakesPage is returned by stake list queries when pagination is requested
+type StakesPage struct {
+	Stakes     []Stake `json:"stakes"`
+	NextCursor string  `json:"next_cursor"`
+}
+
+// ArgumentsPage is returned by argument list queries when pagination is requested
+type ArgumentsPage struct {
+	Arguments  []Argument `json:"arguments"`
+	NextCursor string     `json:"next_cursor"`
+}
+
+// ClaimRankedArguments holds the ranked backing and challenge arguments of a claim
+type ClaimRankedArguments struct {
+	Backing   []RankedArgument `json:"backing"`
+	Challenge []RankedArgument `json:"challenge"`
+}
+
 // NewQuerier creates a new querier
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
@@ -101,6 +160,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTotalEarnedCoins(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, keeper)
+		case QueryStakeLimit:
+			return queryStakeLimit(ctx, req, keeper)
+		case QueryArgumentRevisions:
+			return queryArgumentRevisions(ctx, req, keeper)
+		case QueryClaimRankedArguments:
+			return queryClaimRankedArguments(ctx, req, keeper)
+		case QueryCommunityParams:
+			return queryCommunityParams(ctx, req, keeper)
+		case QueryFailedExpiringStakes:
+			return queryFailures(keeper.FailedExpiringStakes(ctx))
+		case QueryFailedClaimResolutions:
+			return queryFailures(keeper.FailedClaimResolutions(ctx))
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown staking query endpoint")
 		}
@@ -130,12 +201,9 @@ func queryUserArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.UserArguments(ctx, params.Address)
-	bz, err := keeper.codec.MarshalJSON(arguments)
-	if err != nil {
-		return nil, ErrJSONParse(err)
-	}
-	return bz, nil
+	return queryArguments(ctx, keeper, userArgumentsPrefix(params.Address), params.Pagination, func() []Argument {
+		return keeper.UserArguments(ctx, params.Address)
+	})
 }
 
 func queryClaimArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -144,12 +212,9 @@ func queryClaimArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.ClaimArguments(ctx, params.ClaimID)
-	bz, err := keeper.codec.MarshalJSON(arguments)
-	if err != nil {
-		return nil, ErrJSONParse(err)
-	}
-	return bz, nil
+	return queryArguments(ctx, keeper, claimArgumentsPrefix(params.ClaimID), params.Pagination, func() []Argument {
+		return keeper.ClaimArguments(ctx, params.ClaimID)
+	})
 }
 
 func queryArgumentStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -158,12 +223,9 @@ func queryArgumentStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.ArgumentStakes(ctx, params.ArgumentID)
-	bz, err := keeper.codec.MarshalJSON(stakes)
-	if err != nil {
-		return nil, ErrJSONParse(err)
-	}
-	return bz, nil
+	return queryStakes(ctx, keeper, argumentStakesPrefix(params.ArgumentID), params.Pagination, func() []Stake {
+		return keeper.ArgumentStakes(ctx, params.ArgumentID)
+	})
 }
 
 func queryCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -172,12 +234,9 @@ func queryCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.CommunityStakes(ctx, params.CommunityID)
-	bz, err := keeper.codec.MarshalJSON(stakes)
-	if err != nil {
-		return nil, ErrJSONParse(err)
-	}
-	return bz, nil
+	return queryStakes(ctx, keeper, communityStakesPrefix(params.CommunityID), params.Pagination, func() []Stake {
+		return keeper.CommunityStakes(ctx, params.CommunityID)
+	})
 }
 
 func queryStake(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -227,12 +286,9 @@ func queryUserStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.UserStakes(ctx, params.Address)
-	bz, err := keeper.codec.MarshalJSON(stakes)
-	if err != nil {
-		return nil, ErrJSONParse(err)
-	}
-	return bz, nil
+	return queryStakes(ctx, keeper, userStakesPrefix(params.Address), params.Pagination, func() []Stake {
+		return keeper.UserStakes(ctx, params.Address)
+	})
 }
 
 func queryUserCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
@@ -241,47 +297,77 @@ func queryUserCommunityStakes(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	stakes := keeper.UserCommunityStakes(ctx, params.Address, params.CommunityID)
-	bz, err := keeper.codec.MarshalJSON(stakes)
+	return queryStakes(ctx, keeper, userCommunityStakesPrefix(params.Address, params.CommunityID), params.Pagination, func() []Stake {
+		return keeper.UserCommunityStakes(ctx, params.Address, params.CommunityID)
+	})
+}
+
+func queryClaimTopArgument(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimTopArgumentParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	topArgument := Argument{}
+	ranked := keeper.RankClaimArguments(ctx, params.ClaimID, RankByStake, false)
+	if len(ranked) > 0 {
+		topArgument = ranked[0].Argument
+	}
+	bz, err := keeper.codec.MarshalJSON(topArgument)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
 	return bz, nil
 }
 
-func queryClaimTopArgument(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params QueryClaimTopArgumentParams
+func queryClaimRankedArguments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryClaimRankedArgumentsParams
 	err := keeper.codec.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, ErrInvalidQueryParams(err)
 	}
-	arguments := keeper.ClaimArguments(ctx, params.ClaimID)
-	topArgument := Argument{}
-	if len(arguments) == 0 {
-		bz, err := keeper.codec.MarshalJSON(topArgument)
-		if err != nil {
-			return nil, ErrJSONParse(err)
-		}
-		return bz, nil
+	if !params.Strategy.Valid() {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("unknown ranking strategy %s", params.Strategy))
 	}
-	for _, a := range arguments {
-		if a.IsUnhelpful {
-			continue
-		}
-		if topArgument.ID == 0 {
-			topArgument = a
-		}
-		if topArgument.UpvotedStake.IsLT(a.UpvotedStake) {
-			topArgument = a
+	if params.Offset < 0 {
+		return nil, ErrInvalidQueryParams(fmt.Errorf("invalid offset %d", params.Offset))
+	}
+	limit := params.Limit
+	if limit <= 0 || limit > app.MaxPageLimit {
+		limit = app.MaxPageLimit
+	}
+	result := ClaimRankedArguments{
+		Backing:   make([]RankedArgument, 0),
+		Challenge: make([]RankedArgument, 0),
+	}
+	for _, r := range keeper.RankClaimArguments(ctx, params.ClaimID, params.Strategy, params.PenalizeDownvotes) {
+		switch r.Argument.StakeType {
+		case StakeBacking:
+			result.Backing = append(result.Backing, r)
+		case StakeChallenge:
+			result.Challenge = append(result.Challenge, r)
 		}
 	}
-	bz, err := keeper.codec.MarshalJSON(topArgument)
+	result.Backing = rankedArgumentsWindow(result.Backing, params.Offset, limit)
+	result.Challenge = rankedArgumentsWindow(result.Challenge, params.Offset, limit)
+	bz, err := keeper.codec.MarshalJSON(result)
 	if err != nil {
 		return nil, ErrJSONParse(err)
 	}
 	return bz, nil
 }
 
+func rankedArgumentsWindow(ranked []RankedArgument, offset, limit int) []RankedArgument {
+	if offset >= len(ranked) {
+		return make([]RankedArgument, 0)
+	}
+	end := offset + limit
+	if end > len(ranked) {
+		end = len(ranked)
+	}
+	return ranked[offset:end]
+}
+
 func queryEarnedCoins(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params QueryEarnedCoinsParams
 	err := keeper.codec.UnmarshalJSON(req.Data, &params)
@@ -310,6 +396,127 @@ func queryTotalEarnedCoins(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func queryStakeLimit(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryStakeLimitParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.StakeLimit(ctx, params.Address))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryCommunityParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryCommunityParamsParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	bz, err := keeper.codec.MarshalJSON(keeper.CommunityParams(ctx, params.CommunityID))
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+func queryArgumentRevisions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryArgumentRevisionsParams
+	err := keeper.codec.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, ErrInvalidQueryParams(err)
+	}
+	if _, ok := keeper.Argument(ctx, params.ArgumentID); !ok {
+		return nil, ErrCodeUnknownArgument(params.ArgumentID)
+	}
+	var result interface{} = keeper.ArgumentRevisions(ctx, params.ArgumentID)
+	if params.Version != 0 {
+		revision, ok := keeper.ArgumentRevision(ctx, params.ArgumentID, params.Version)
+		if !ok {
+			return nil, ErrCodeUnknownArgumentRevision(params.ArgumentID, params.Version)
+		}
+		result = revision
+	}
+	bz, err := keeper.codec.MarshalJSON(result)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+// queryStakes marshals every stake returned by all, or a single page of the
+// stakes indexed under prefix when pagination is requested
+func queryStakes(ctx sdk.Context, keeper Keeper, prefix []byte, pagination app.Pagination, all func() []Stake) ([]byte, sdk.Error) {
+	var result interface{}
+	if pagination.Paginated() {
+		page := StakesPage{Stakes: make([]Stake, 0)}
+		next, err := app.PaginateStore(keeper.store(ctx), prefix, pagination, app.SortAsc, func(value []byte) bool {
+			var stakeID uint64
+			keeper.codec.MustUnmarshalBinaryLengthPrefixed(value, &stakeID)
+			stake, ok := keeper.Stake(ctx, stakeID)
+			if !ok {
+				return false
+			}
+			page.Stakes = append(page.Stakes, stake)
+			return true
+		})
+		if err != nil {
+			return nil, ErrInvalidQueryParams(err)
+		}
+		page.NextCursor = next
+		result = page
+	} else {
+		result = all()
+	}
+	bz, err := keeper.codec.MarshalJSON(result)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+// queryArguments marshals every argument returned by all, or a single page of the
+// arguments indexed under prefix when pagination is requested
+func queryArguments(ctx sdk.Context, keeper Keeper, prefix []byte, pagination app.Pagination, all func() []Argument) ([]byte, sdk.Error) {
+	var result interface{}
+	if pagination.Paginated() {
+		page := ArgumentsPage{Arguments: make([]Argument, 0)}
+		next, err := app.PaginateStore(keeper.store(ctx), prefix, pagination, app.SortAsc, func(value []byte) bool {
+			var argumentID uint64
+			keeper.codec.MustUnmarshalBinaryLengthPrefixed(value, &argumentID)
+			argument, ok := keeper.Argument(ctx, argumentID)
+			if !ok {
+				return false
+			}
+			page.Arguments = append(page.Arguments, argument)
+			return true
+		})
+		if err != nil {
+			return nil, ErrInvalidQueryParams(err)
+		}
+		page.NextCursor = next
+		result = page
+	} else {
+		result = all()
+	}
+	bz, err := keeper.codec.MarshalJSON(result)
+	if err != nil {
+		return nil, ErrJSONParse(err)
+	}
+	return bz, nil
+}
+
+// queryFailures returns the stakes or claims the end blocker failed to process
+func queryFailures(failures []ProcessingFailure) ([]byte, sdk.Error) {
+	bz, err := ModuleCodec.MarshalJSON(failures)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, keeper Keeper) (result []byte, err sdk.Error) {
 	params := keeper.GetParams(ctx)
 
@@ -11,6 +11,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/community"
 )
 
 func TestQuerier_EmptyTopArgument(t *testing.T) {
@@ -150,6 +151,47 @@ func TestQuerier_CommunityStakes(t *testing.T) {
 	assert.Len(t, stakes, 2)
 }
 
+func TestQuerier_CommunityStakesPaginated(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(time.Now())
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitArgument(ctx, "body", "summary", addr, 2, StakeBacking)
+	assert.NoError(t, err)
+	_, err = k.SubmitArgument(ctx, "body", "summary", addr, 3, StakeBacking)
+	assert.NoError(t, err)
+	claim1, _ := k.claimKeeper.Claim(ctx, argument.ClaimID)
+
+	querier := NewQuerier(k)
+	queryParams := QueryCommunityStakesParams{
+		CommunityID: claim1.CommunityID,
+		Pagination:  app.Pagination{Limit: 2, SortOrder: app.SortDesc},
+	}
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryCommunityStakes}, "/"),
+		Data: k.codec.MustMarshalJSON(&queryParams),
+	}
+	bz, err := querier(ctx, []string{QueryCommunityStakes}, query)
+	assert.NoError(t, err)
+	page := StakesPage{}
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &page))
+	assert.Len(t, page.Stakes, 2)
+	assert.Equal(t, uint64(3), page.Stakes[0].ID)
+	assert.NotEmpty(t, page.NextCursor)
+
+	queryParams.Pagination.Cursor = page.NextCursor
+	query.Data = k.codec.MustMarshalJSON(&queryParams)
+	bz, err = querier(ctx, []string{QueryCommunityStakes}, query)
+	assert.NoError(t, err)
+	page = StakesPage{}
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &page))
+	assert.Len(t, page.Stakes, 1)
+	assert.Equal(t, uint64(1), page.Stakes[0].ID)
+	assert.Empty(t, page.NextCursor)
+}
+
 func TestQuerier_UserCommunityStakes(t *testing.T) {
 	ctx, k, mdb := mockDB()
 	ctx = ctx.WithBlockTime(time.Now())
@@ -201,3 +243,172 @@ func TestQueryParams_Success(t *testing.T) {
 	assert.Nil(t, sdkErr)
 	assert.Equal(t, returnedParams, onChainParams)
 }
+
+func TestQuerier_StakeLimit(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*700)})
+	k.setEarnedCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("crypto", app.Shanev*12)))
+	_, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryStakeLimit}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryStakeLimitParams{Address: addr}),
+	}
+	bz, err := querier(ctx, []string{QueryStakeLimit}, query)
+	assert.NoError(t, err)
+	limit := StakeLimitStatus{}
+	jsonErr := k.codec.UnmarshalJSON(bz, &limit)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, 1, limit.Tier)
+	assert.Equal(t, sdk.NewInt(app.Shanev*1000), limit.StakeLimit)
+	assert.Equal(t, sdk.NewInt(app.Shanev*50), limit.Staked)
+	assert.Equal(t, sdk.NewInt(app.Shanev*950), limit.Remaining)
+	assert.NotNil(t, limit.NextTier)
+	assert.Equal(t, sdk.NewInt(app.Shanev*20), limit.NextTier.EarnedCoins)
+	assert.Equal(t, sdk.NewInt(app.Shanev*8), limit.EarnedCoinsToNextTier)
+}
+
+func TestQuerier_CommunityParams(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	upvoteStake := sdk.NewInt64Coin(app.StakeDenom, app.Shanev*25)
+	mdb.communityKeeper.communities["politics"] = community.Community{
+		ID:               "politics",
+		StakingOverrides: community.StakingOverrides{UpvoteStake: &upvoteStake},
+	}
+
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryCommunityParams}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryCommunityParamsParams{CommunityID: "politics"}),
+	}
+	bz, err := querier(ctx, []string{QueryCommunityParams}, query)
+	assert.NoError(t, err)
+	p := Params{}
+	jsonErr := k.codec.UnmarshalJSON(bz, &p)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, upvoteStake, p.UpvoteStake)
+	assert.Equal(t, k.GetParams(ctx).Period, p.Period)
+}
+
+func TestQuerier_Failures(t *testing.T) {
+	ctx, k, _ := mockDB()
+	k.setFailedExpiringStake(ctx, 3, "unknown argument")
+	k.setFailedClaimResolution(ctx, 7, "insufficient funds")
+
+	querier := NewQuerier(k)
+	bz, err := querier(ctx, []string{QueryFailedExpiringStakes}, abci.RequestQuery{})
+	assert.NoError(t, err)
+	failures := make([]ProcessingFailure, 0)
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &failures))
+	assert.Equal(t, []ProcessingFailure{{ID: 3, Reason: "unknown argument"}}, failures)
+
+	bz, err = querier(ctx, []string{QueryFailedClaimResolutions}, abci.RequestQuery{})
+	assert.NoError(t, err)
+	assert.NoError(t, k.codec.UnmarshalJSON(bz, &failures))
+	assert.Equal(t, []ProcessingFailure{{ID: 7, Reason: "insufficient funds"}}, failures)
+
+	// a successful retry clears the record
+	k.deleteFailedExpiringStake(ctx, 3)
+	assert.Len(t, k.FailedExpiringStakes(ctx), 0)
+}
+
+func TestQuerier_ArgumentRevisions(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-06-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	argument, err := k.SubmitArgument(ctx, "body", "summary", addr, 1, StakeChallenge)
+	assert.NoError(t, err)
+	edited, err := k.EditArgument(ctx.WithBlockTime(mustParseTime("2019-06-02")), "new body", "new summary", addr, argument.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), edited.Version)
+	assert.Equal(t, "new body", edited.Body)
+
+	querier := NewQuerier(k)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", QuerierRoute, QueryArgumentRevisions}, "/"),
+		Data: k.codec.MustMarshalJSON(QueryArgumentRevisionsParams{ArgumentID: argument.ID}),
+	}
+	bz, err := querier(ctx, []string{QueryArgumentRevisions}, query)
+	assert.NoError(t, err)
+	revisions := make([]ArgumentRevision, 0)
+	jsonErr := k.codec.UnmarshalJSON(bz, &revisions)
+	assert.NoError(t, jsonErr)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "body", revisions[0].Body)
+	assert.Equal(t, "new body", revisions[1].Body)
+	assert.Equal(t, mustParseTime("2019-06-02"), revisions[1].CreatedTime)
+
+	query.Data = k.codec.MustMarshalJSON(QueryArgumentRevisionsParams{ArgumentID: argument.ID, Version: 1})
+	bz, err = querier(ctx, []string{QueryArgumentRevisions}, query)
+	assert.NoError(t, err)
+	revision := ArgumentRevision{}
+	jsonErr = k.codec.UnmarshalJSON(bz, &revision)
+	assert.NoError(t, jsonErr)
+	assert.Equal(t, "summary", revision.Summary)
+	assert.Equal(t, addr, revision.Editor)
+
+	query.Data = k.codec.MustMarshalJSON(QueryArgumentRevisionsParams{ArgumentID: argument.ID, Version: 3})
+	_, err = querier(ctx, []string{QueryArgumentRevisions}, query)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeUnknownArgumentRevision, err.Code())
+}
+
+func TestQuerier_ClaimRankedArguments(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-06-01"))
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*300)})
+	arg1, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	arg2, err := k.SubmitArgument(ctx, "arg2", "summary2", addr2, 1, StakeBacking)
+	assert.NoError(t, err)
+	arg3, err := k.SubmitArgument(ctx, "arg3", "summary3", addr3, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, arg2.ID, addr3)
+	assert.NoError(t, err)
+
+	querier := NewQuerier(k)
+	rank := func(params QueryClaimRankedArgumentsParams) (ClaimRankedArguments, sdk.Error) {
+		result := ClaimRankedArguments{}
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", QuerierRoute, QueryClaimRankedArguments}, "/"),
+			Data: k.codec.MustMarshalJSON(params),
+		}
+		bz, err := querier(ctx, []string{QueryClaimRankedArguments}, query)
+		if err != nil {
+			return result, err
+		}
+		k.codec.MustUnmarshalJSON(bz, &result)
+		return result, nil
+	}
+
+	ranked, err := rank(QueryClaimRankedArgumentsParams{ClaimID: 1})
+	assert.NoError(t, err)
+	assert.Len(t, ranked.Backing, 2)
+	assert.Equal(t, arg2.ID, ranked.Backing[0].Argument.ID)
+	assert.Equal(t, arg1.ID, ranked.Backing[1].Argument.ID)
+	assert.Equal(t, sdk.NewDec(app.Shanev*10), ranked.Backing[0].Score)
+	assert.Len(t, ranked.Challenge, 1)
+	assert.Equal(t, arg3.ID, ranked.Challenge[0].Argument.ID)
+
+	ranked, err = rank(QueryClaimRankedArgumentsParams{ClaimID: 1, Strategy: RankByUpvotes, Limit: 1, Offset: 1})
+	assert.NoError(t, err)
+	assert.Len(t, ranked.Backing, 1)
+	assert.Equal(t, arg1.ID, ranked.Backing[0].Argument.ID)
+	assert.Len(t, ranked.Challenge, 0)
+
+	// two downvotes bring the upvoted argument below the first one
+	assert.NoError(t, k.DownvoteArgument(ctx, arg2.ID))
+	assert.NoError(t, k.DownvoteArgument(ctx, arg2.ID))
+	ranked, err = rank(QueryClaimRankedArgumentsParams{ClaimID: 1, Strategy: RankByHot, PenalizeDownvotes: true})
+	assert.NoError(t, err)
+	assert.Equal(t, arg1.ID, ranked.Backing[0].Argument.ID)
+	assert.Equal(t, arg2.ID, ranked.Backing[1].Argument.ID)
+
+	_, err = rank(QueryClaimRankedArgumentsParams{ClaimID: 1, Strategy: "random"})
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeInvalidQueryParams, err.Code())
+}
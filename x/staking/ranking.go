@@ -0,0 +1,81 @@
+package staking
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RankingStrategy selects how the arguments of a claim are scored
+type RankingStrategy string
+
+// Ranking strategies
+const (
+	// RankByStake scores arguments by the amount staked on upvotes
+	RankByStake RankingStrategy = "stake"
+	// RankByUpvotes scores arguments by their number of upvotes
+	RankByUpvotes RankingStrategy = "upvotes"
+	// RankByHot scores arguments by their total stake decayed by the hours since creation
+	RankByHot RankingStrategy = "hot"
+)
+
+// RankedArgument is an argument with the score it was ranked by
+type RankedArgument struct {
+	Argument Argument `json:"argument"`
+	Score    sdk.Dec  `json:"score"`
+}
+
+// Valid tells whether the strategy is known, the empty strategy ranks by stake
+func (s RankingStrategy) Valid() bool {
+	switch s {
+	case "", RankByStake, RankByUpvotes, RankByHot:
+		return true
+	}
+	return false
+}
+
+// RankClaimArguments returns the helpful arguments of a claim ordered by score.
+// With penalizeDownvotes set scores are divided by 1 + DownvotedCount.
+// Ties go to the earliest argument.
+func (k Keeper) RankClaimArguments(ctx sdk.Context, claimID uint64,
+	strategy RankingStrategy, penalizeDownvotes bool) []RankedArgument {
+	ranked := make([]RankedArgument, 0)
+	k.IterateClaimArguments(ctx, claimID, func(argument Argument) bool {
+		if argument.IsUnhelpful {
+			return false
+		}
+		score := k.argumentScore(ctx, argument, strategy)
+		if penalizeDownvotes {
+			score = score.QuoInt64(int64(argument.DownvotedCount) + 1)
+		}
+		ranked = append(ranked, RankedArgument{Argument: argument, Score: score})
+		return false
+	})
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if !a.Score.Equal(b.Score) {
+			return a.Score.GT(b.Score)
+		}
+		if !a.Argument.CreatedTime.Equal(b.Argument.CreatedTime) {
+			return a.Argument.CreatedTime.Before(b.Argument.CreatedTime)
+		}
+		return a.Argument.ID < b.Argument.ID
+	})
+	return ranked
+}
+
+func (k Keeper) argumentScore(ctx sdk.Context, argument Argument, strategy RankingStrategy) sdk.Dec {
+	switch strategy {
+	case RankByUpvotes:
+		return sdk.NewDec(int64(argument.UpvotedCount))
+	case RankByHot:
+		hours := int64(ctx.BlockHeader().Time.Sub(argument.CreatedTime).Hours())
+		if hours < 0 {
+			hours = 0
+		}
+		// the score drops with the square of the age so recent arguments rise to the top
+		return argument.TotalStake.Amount.ToDec().Quo(sdk.NewDec(hours + 2).Power(2))
+	default:
+		return argument.UpvotedStake.Amount.ToDec()
+	}
+}
@@ -0,0 +1,189 @@
+package staking
+
+import (
+	"fmt"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/claim"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// processClosedClaims resolves at most MaxResolvedClaimsPerBlock closed claims per block.
+// When the cap is hit the next claim ID is kept as a cursor so the next block resumes
+// from there. Claims that fail are recorded and left closed, they are retried once the
+// cursor wraps around.
+func (k Keeper) processClosedClaims(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	store := k.store(ctx)
+	limit := k.GetParams(ctx).MaxResolvedClaimsPerBlock
+
+	var start uint64
+	if cursor := store.Get(ClosedClaimsCursorKey); cursor != nil {
+		k.codec.MustUnmarshalBinaryLengthPrefixed(cursor, &start)
+	}
+	claims := k.claimKeeper.VotingClosedClaimsFrom(ctx, start, limit)
+	if len(claims) < limit {
+		store.Delete(ClosedClaimsCursorKey)
+	} else {
+		store.Set(ClosedClaimsCursorKey, k.codec.MustMarshalBinaryLengthPrefixed(claims[len(claims)-1].ID+1))
+	}
+	if len(claims) == 0 {
+		return
+	}
+
+	stories := make([]app.CompletedStory, 0, len(claims))
+	for _, c := range claims {
+		// resolve in a cached context so a failing claim leaves no partial payouts
+		cacheCtx, write := ctx.CacheContext()
+		story, err := k.resolveClaim(cacheCtx, c)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Skipping closed claimID %d: %s", c.ID, err.Error()))
+			k.setFailedClaimResolution(ctx, c.ID, err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeClaimResolutionFailed,
+					sdk.NewAttribute(AttributeKeyClaimID, fmt.Sprintf("%d", c.ID)),
+					sdk.NewAttribute(AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+		k.deleteFailedClaimResolution(ctx, c.ID)
+		stories = append(stories, story)
+	}
+	if len(stories) == 0 {
+		return
+	}
+
+	b, err := k.codec.MarshalJSON(app.CompletedStoriesNotificationResult{Stories: stories})
+	if err != nil {
+		logger.Error(fmt.Sprintf("Error marshalling completed stories: %s", err.Error()))
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClaimsResolved,
+			sdk.NewAttribute(AttributeKeyCompletedStories, string(b)),
+		),
+	)
+}
+
+func (k Keeper) resolveClaim(ctx sdk.Context, c claim.Claim) (app.CompletedStory, sdk.Error) {
+	backingStakes, challengeStakes := k.activeClaimStakes(ctx, c.ID)
+
+	var winners, losers []Stake
+	switch c.Verdict {
+	case app.DistributionBackersWin:
+		winners, losers = backingStakes, challengeStakes
+	case app.DistributionChallengersWin:
+		winners, losers = challengeStakes, backingStakes
+	}
+
+	rewards, pool, err := k.redistributeStakes(ctx, c, winners, losers)
+	if err != nil {
+		return app.CompletedStory{}, err
+	}
+
+	err = k.claimKeeper.ResolveClaim(ctx, c.ID)
+	if err != nil {
+		return app.CompletedStory{}, err
+	}
+	k.Logger(ctx).Info("Resolved " + c.String())
+
+	return app.CompletedStory{
+		ID:          int64(c.ID),
+		Creator:     c.Creator,
+		Backers:     stakers(backingStakes),
+		Challengers: stakers(challengeStakes),
+		StakeDistributionResults: app.StakeDistributionResults{
+			Type:        c.Verdict,
+			TotalAmount: pool,
+			Rewards:     rewards,
+		},
+		// interest is still paid out when each winning stake expires
+		InterestDistributionResults: app.InterestDistributionResults{
+			TotalAmount: sdk.NewInt64Coin(app.StakeDenom, 0),
+			Interests:   make([]app.Interest, 0),
+		},
+	}, nil
+}
+
+// activeClaimStakes returns the non expired stakes on a claim split by side
+func (k Keeper) activeClaimStakes(ctx sdk.Context, claimID uint64) (backing, challenge []Stake) {
+	backing, challenge = make([]Stake, 0), make([]Stake, 0)
+	for _, argument := range k.ClaimArguments(ctx, claimID) {
+		k.IterateArgumentStakes(ctx, argument.ID, func(stake Stake) bool {
+			if stake.Expired {
+				return false
+			}
+			switch argument.StakeType {
+			case StakeBacking:
+				backing = append(backing, stake)
+			case StakeChallenge:
+				challenge = append(challenge, stake)
+			}
+			return false
+		})
+	}
+	return backing, challenge
+}
+
+// redistributeStakes forfeits the losing stakes and splits them between the winning stakes
+// in proportion to the amount staked. Winning stakes stay active and still earn interest.
+func (k Keeper) redistributeStakes(ctx sdk.Context, c claim.Claim,
+	winners, losers []Stake) ([]app.StakeReward, sdk.Coin, sdk.Error) {
+	rewards := make([]app.StakeReward, 0)
+	pool := sdk.NewInt64Coin(app.StakeDenom, 0)
+
+	winnersTotal := sdk.ZeroInt()
+	for _, stake := range winners {
+		winnersTotal = winnersTotal.Add(stake.Amount.Amount)
+	}
+	// nobody left to pay out, losing stakes are treated as regular stakes
+	if winnersTotal.IsZero() || len(losers) == 0 {
+		return rewards, pool, nil
+	}
+
+	// forfeited coins stay in the stakes pool until they are paid out to the winners
+	for _, stake := range losers {
+		stake.Expired = true
+		k.setStake(ctx, stake)
+		k.RemoveFromActiveStakeQueue(ctx, stake.ID, stake.EndTime)
+		pool = pool.Add(stake.Amount)
+	}
+
+	remaining := pool.Amount
+	for i, stake := range winners {
+		share := pool.Amount.Mul(stake.Amount.Amount).Quo(winnersTotal)
+		// last winner receives the rounding remainder so the pool is fully paid out
+		if i == len(winners)-1 {
+			share = remaining
+		}
+		remaining = remaining.Sub(share)
+		if share.IsZero() {
+			continue
+		}
+		reward := sdk.NewCoin(app.StakeDenom, share)
+		_, err := k.bankKeeper.AddCoin(ctx, stake.Creator, reward, stake.ArgumentID,
+			TransactionStakeWinnings, WithCommunityID(c.CommunityID),
+			FromModuleAccount(UserStakesPoolName),
+		)
+		if err != nil {
+			return nil, pool, err
+		}
+		k.AddEarnedCoin(ctx, stake.Creator, c.CommunityID, share)
+		rewards = append(rewards, app.StakeReward{Account: stake.Creator, Amount: reward})
+	}
+
+	return rewards, pool, nil
+}
+
+func stakers(stakes []Stake) []app.Staker {
+	s := make([]app.Staker, 0, len(stakes))
+	for _, stake := range stakes {
+		s = append(s, app.Staker{Address: stake.Creator, Amount: stake.Amount})
+	}
+	return s
+}
@@ -0,0 +1,123 @@
+package staking
+
+import (
+	"testing"
+
+	app "github.com/ahmedaly113/ahchain/types"
+	"github.com/ahmedaly113/ahchain/x/claim"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ResolveClaim(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	addr := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr2 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	addr3 := createFakeFundedAccount(ctx, mdb.authAccKeeper, sdk.Coins{sdk.NewInt64Coin(app.StakeDenom, app.Shanev*250)})
+	claims := make(map[uint64]claim.Claim)
+	claims[1] = claim.Claim{
+		ID:          1,
+		CommunityID: "crypto",
+		Body:        "body",
+		Creator:     addr,
+	}
+	mockedClaimKeeper.SetClaims(claims)
+
+	backing, err := k.SubmitArgument(ctx, "arg1", "summary1", addr, 1, StakeBacking)
+	assert.NoError(t, err)
+	challenge, err := k.SubmitArgument(ctx, "arg2", "summary2", addr2, 1, StakeChallenge)
+	assert.NoError(t, err)
+	_, err = k.SubmitUpvote(ctx, challenge.ID, addr3)
+	assert.NoError(t, err)
+
+	c := claims[1]
+	c.Status = claim.ClaimVotingClosed
+	c.Verdict = app.DistributionBackersWin
+	claims[1] = c
+
+	_, err = k.SubmitUpvote(ctx, backing.ID, addr3)
+	assert.Error(t, err)
+	assert.Equal(t, ErrorCodeClaimNotOpen, err.Code())
+
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-02")), k)
+
+	c, _ = mockedClaimKeeper.Claim(ctx, 1)
+	assert.Equal(t, claim.ClaimResolved, c.Status)
+
+	// losing stakes are forfeited to the winning side
+	assert.Equal(t, sdk.NewInt(app.Shanev*260), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*200), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*240), k.bankKeeper.GetCoins(ctx, addr3).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*60), k.TotalEarnedCoins(ctx, addr))
+
+	for _, stake := range k.ArgumentStakes(ctx, challenge.ID) {
+		assert.True(t, stake.Expired)
+	}
+	for _, stake := range k.ArgumentStakes(ctx, backing.ID) {
+		assert.False(t, stake.Expired)
+	}
+
+	// winning stakes still earn interest when they expire
+	EndBlocker(ctx.WithBlockTime(mustParseTime("2019-01-13")), k)
+	interest := k.interest(ctx, "crypto", sdk.NewInt64Coin(app.StakeDenom, app.Shanev*50), k.GetParams(ctx).Period).RoundInt()
+	assert.Equal(t, sdk.NewInt(app.Shanev*310).Add(interest), k.bankKeeper.GetCoins(ctx, addr).AmountOf(app.StakeDenom))
+	assert.Equal(t, sdk.NewInt(app.Shanev*200), k.bankKeeper.GetCoins(ctx, addr2).AmountOf(app.StakeDenom))
+}
+
+func TestKeeper_ProcessClosedClaimsCapAndFailures(t *testing.T) {
+	ctx, k, mdb := mockDB()
+	ctx = ctx.WithBlockTime(mustParseTime("2019-01-01"))
+	mockedClaimKeeper := mdb.claimKeeper.(*mockClaimKeeper)
+	p := k.GetParams(ctx)
+	p.MaxResolvedClaimsPerBlock = 2
+	k.SetParams(ctx, p)
+
+	claims := make(map[uint64]claim.Claim)
+	for id := uint64(1); id <= 3; id++ {
+		claims[id] = claim.Claim{
+			ID:          id,
+			CommunityID: "crypto",
+			Body:        "body",
+			Status:      claim.ClaimVotingClosed,
+			Verdict:     app.DistributionMajorityNotReached,
+		}
+	}
+	mockedClaimKeeper.SetClaims(claims)
+	mockedClaimKeeper.unresolvable = map[uint64]bool{1: true}
+
+	k.processClosedClaims(ctx)
+	c, _ := mockedClaimKeeper.Claim(ctx, 1)
+	assert.Equal(t, claim.ClaimVotingClosed, c.Status)
+	c, _ = mockedClaimKeeper.Claim(ctx, 2)
+	assert.Equal(t, claim.ClaimResolved, c.Status)
+	c, _ = mockedClaimKeeper.Claim(ctx, 3)
+	assert.Equal(t, claim.ClaimVotingClosed, c.Status)
+
+	failed := make(map[uint64]string)
+	k.IterateFailedClaimResolutions(ctx, func(claimID uint64, reason string) bool {
+		failed[claimID] = reason
+		return false
+	})
+	assert.Len(t, failed, 1)
+	assert.Contains(t, failed, uint64(1))
+
+	// next block resumes after the cursor
+	k.processClosedClaims(ctx)
+	c, _ = mockedClaimKeeper.Claim(ctx, 3)
+	assert.Equal(t, claim.ClaimResolved, c.Status)
+
+	// failed claim is retried once the cursor wraps around
+	mockedClaimKeeper.unresolvable = nil
+	k.processClosedClaims(ctx)
+	c, _ = mockedClaimKeeper.Claim(ctx, 1)
+	assert.Equal(t, claim.ClaimResolved, c.Status)
+	failed = make(map[uint64]string)
+	k.IterateFailedClaimResolutions(ctx, func(claimID uint64, reason string) bool {
+		failed[claimID] = reason
+		return false
+	})
+	assert.Len(t, failed, 0)
+}
@@ -62,7 +62,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		return RewardResult{}, err
 	}
 
-	interest := k.interest(ctx, stake.Amount, stake.EndTime.Sub(stake.CreatedTime))
+	interest := k.interest(ctx, stake.CommunityID, stake.Amount, stake.EndTime.Sub(stake.CreatedTime))
 	// creator receives 100% interest of his own stake
 	if argument.Creator.Equals(stake.Creator) {
 		reward := sdk.NewCoin(app.StakeDenom, interest.RoundInt())
@@ -77,7 +77,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		if err != nil {
 			return RewardResult{}, err
 		}
-		k.addEarnedCoin(ctx, argument.Creator, claim.CommunityID, reward.Amount)
+		k.AddEarnedCoin(ctx, argument.Creator, claim.CommunityID, reward.Amount)
 		return RewardResult{Type: RewardResultArgumentCreation,
 			ArgumentCreator:       argument.Creator,
 			ArgumentCreatorReward: reward}, nil
@@ -109,8 +109,8 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 		return RewardResult{}, err
 	}
 
-	k.addEarnedCoin(ctx, argument.Creator, claim.CommunityID, creatorRewardCoin.Amount)
-	k.addEarnedCoin(ctx, stake.Creator, claim.CommunityID, stakerRewardCoin.Amount)
+	k.AddEarnedCoin(ctx, argument.Creator, claim.CommunityID, creatorRewardCoin.Amount)
+	k.AddEarnedCoin(ctx, stake.Creator, claim.CommunityID, stakerRewardCoin.Amount)
 	rewardResult := RewardResult{
 		Type:                  RewardResultUpvoteSplit,
 		ArgumentCreator:       argument.Creator,
@@ -121,8 +121,8 @@ func (k Keeper) distributeReward(ctx sdk.Context, stake Stake) (RewardResult, sd
 	return rewardResult, nil
 }
 
-func (k Keeper) interest(ctx sdk.Context, amount sdk.Coin, period time.Duration) sdk.Dec {
-	interestRate := k.GetParams(ctx).InterestRate
+func (k Keeper) interest(ctx sdk.Context, communityID string, amount sdk.Coin, period time.Duration) sdk.Dec {
+	interestRate := k.CommunityParams(ctx, communityID).InterestRate
 	return Interest(interestRate, amount, period)
 }
 
@@ -17,14 +17,32 @@ const (
 	DefaultParamspace = ModuleName
 
 	EventTypeInterestRewardPaid = "interest-reward-paid"
-	AttributeKeyExpiredStakes   = "expired-stakes"
+	EventTypeStakeExpiryFailed  = "stake-expiry-failed"
+	AttributeKeyStakeID         = "stake-id"
+	AttributeKeyArgumentID      = "argument-id"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyReward          = "reward"
+	AttributeKeyError           = "error"
 
 	EventTypeStakeLimitIncreased  = "stake-limit-increased"
 	AttributeKeyStakeLimitUpgrade = "stake-limit-upgrade"
 
+	EventTypeArgumentDeleted = "argument-deleted"
+	AttributeKeyClaimID      = "claim-id"
+
+	EventTypeStakeWithdrawn = "stake-withdrawn"
+	AttributeKeyPenalty     = "penalty"
+
+	EventTypeClaimsResolved        = "claims-resolved"
+	EventTypeClaimResolutionFailed = "claim-resolution-failed"
+	AttributeKeyCompletedStories   = "completed-stories"
+
 	UserStakesPoolName = "user_stakes_tokens_pool"
 )
 
+// restrictedArgumentsPerClaim is how many arguments a jailed user can post on a claim
+const restrictedArgumentsPerClaim = 1
+
 type StakeType byte
 
 func (t StakeType) String() string {
@@ -59,6 +77,20 @@ func (t StakeType) BankTransactionType() bank.TransactionType {
 	return bankTransactionMappings[t]
 }
 
+var refundTransactionMappings = []TransactionType{
+	StakeBacking:   TransactionBackingReturned,
+	StakeChallenge: TransactionChallengeReturned,
+	StakeUpvote:    TransactionUpvoteReturned,
+}
+
+// RefundTransactionType returns the bank transaction type used when the stake is returned
+func (t StakeType) RefundTransactionType() bank.TransactionType {
+	if int(t) >= len(refundTransactionMappings) {
+		panic("invalid stake type")
+	}
+	return refundTransactionMappings[t]
+}
+
 func (t StakeType) ValidForArgument() bool {
 	return t.oneOf([]StakeType{StakeBacking, StakeChallenge})
 }
@@ -90,6 +122,7 @@ type Stake struct {
 	CreatedTime time.Time      `json:"created_time"`
 	EndTime     time.Time      `json:"end_time"`
 	Expired     bool           `json:"expired"`
+	Withdrawn   bool           `json:"withdrawn"`
 	Result      *RewardResult  `json:"result,omitempty"`
 }
 
@@ -118,6 +151,51 @@ type Argument struct {
 	UpdatedTime    time.Time      `json:"updated_time"`
 	EditedTime     time.Time      `json:"edited_time"`
 	Edited         bool           `json:"edited"`
+	Version        uint64         `json:"version"`
+	// JailEpisode is the jail episode of the creator a restricted argument was posted during,
+	// zero for arguments posted while not jailed
+	JailEpisode int `json:"jail_episode"`
+}
+
+// ArgumentRevision is the content of an argument at a given version.
+// Version 1 is the content the argument was submitted with.
+type ArgumentRevision struct {
+	ArgumentID  uint64         `json:"argument_id"`
+	Version     uint64         `json:"version"`
+	Summary     string         `json:"summary"`
+	Body        string         `json:"body"`
+	Editor      sdk.AccAddress `json:"editor"`
+	CreatedTime time.Time      `json:"created_time"`
+}
+
+func newArgumentRevision(argument Argument, editor sdk.AccAddress, createdTime time.Time) ArgumentRevision {
+	return ArgumentRevision{
+		ArgumentID:  argument.ID,
+		Version:     argument.Version,
+		Summary:     argument.Summary,
+		Body:        argument.Body,
+		Editor:      editor,
+		CreatedTime: createdTime,
+	}
+}
+
+// StakeLimitTier raises the stake limit for users that earned at least EarnedCoins
+type StakeLimitTier struct {
+	EarnedCoins sdk.Int `json:"earned_coins"`
+	StakeLimit  sdk.Int `json:"stake_limit"`
+}
+
+// StakeLimitStatus describes the stake limit currently applied to a user
+type StakeLimitStatus struct {
+	Address     sdk.AccAddress `json:"address"`
+	Tier        int            `json:"tier"`
+	EarnedCoins sdk.Int        `json:"earned_coins"`
+	StakeLimit  sdk.Int        `json:"stake_limit"`
+	Staked      sdk.Int        `json:"staked"`
+	Remaining   sdk.Int        `json:"remaining"`
+	// NextTier is nil when the user already reached the highest tier
+	NextTier              *StakeLimitTier `json:"next_tier,omitempty"`
+	EarnedCoinsToNextTier sdk.Int         `json:"earned_coins_to_next_tier"`
 }
 
 type StakeLimitUpgrade struct {
@@ -125,3 +203,10 @@ type StakeLimitUpgrade struct {
 	NewLimit    int            `json:"new_limit"`
 	EarnedStake sdk.Coin       `json:"earned_stake"`
 }
+
+// ProcessingFailure records why the end blocker couldn't expire a stake or resolve a claim.
+// The stake or claim stays queued and is retried, the record is cleared once it succeeds.
+type ProcessingFailure struct {
+	ID     uint64 `json:"id"`
+	Reason string `json:"reason"`
+}